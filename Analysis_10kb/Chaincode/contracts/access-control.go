@@ -0,0 +1,258 @@
+package contracts
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+const accessPolicyKeyPrefix string = "acl"
+
+// MSP IDs used for each organisation by the test network, the Org1-3 samples and minifab
+var (
+	manufacturerMSPs = []string{"ManufacturerMSP", "Org1MSP", "manufacturer-auto-com"}
+	dealerMSPs       = []string{"DealerMSP", "Org2MSP", "dealer-auto-com"}
+	mvdMSPs          = []string{"MvdMSP", "Org3MSP", "mvd-auto-com"}
+)
+
+// AccessRule lists the MSP IDs allowed to call a function and the certificate attributes the caller must hold
+type AccessRule struct {
+	MSPIDs     []string          `json:"mspIds"`
+	Attributes map[string]string `json:"attributes,omitempty" metadata:",optional"`
+}
+
+// AccessPolicy maps contract functions, named as Contract:Function, to their access rules
+type AccessPolicy struct {
+	AssetType string                `json:"assetType"`
+	Rules     map[string]AccessRule `json:"rules"`
+}
+
+func anyOf(groups ...[]string) AccessRule {
+	var mspIDs []string
+	for _, group := range groups {
+		mspIDs = append(mspIDs, group...)
+	}
+	return AccessRule{MSPIDs: mspIDs}
+}
+
+// defaultAccessPolicy is used for every function that has no rule stored on the ledger
+func defaultAccessPolicy() *AccessPolicy {
+	members := anyOf(manufacturerMSPs, dealerMSPs, mvdMSPs)
+	manufacturers := anyOf(manufacturerMSPs)
+	dealers := anyOf(dealerMSPs)
+	mvds := anyOf(mvdMSPs)
+	collectionMembers := anyOf(manufacturerMSPs, dealerMSPs)
+	admins := anyOf(manufacturerMSPs)
+	admins.Attributes = map[string]string{"role": "admin"}
+	dealerAdmins := anyOf(dealerMSPs)
+	dealerAdmins.Attributes = map[string]string{"role": "admin"}
+
+	return &AccessPolicy{
+		AssetType: "acl",
+		Rules: map[string]AccessRule{
+			"CarContract:CarExists":               members,
+			"CarContract:CreateCar":               manufacturers,
+			"CarContract:ReadCar":                 members,
+			"CarContract:EndorsementInfo":         members,
+			"CarContract:UpdateCar":               manufacturers,
+			"CarContract:PatchCar":                manufacturers,
+			"CarContract:DeleteCar":               manufacturers,
+			"CarContract:ArchiveCar":              manufacturers,
+			"CarContract:RestoreCar":              manufacturers,
+			"CarContract:PurgeCar":                admins,
+			"CarContract:GetArchivedCars":         members,
+			"CarContract:GetCarsByRange":          members,
+			"CarContract:GetAllCars":              members,
+			"CarContract:GetCarHistory":           members,
+			"CarContract:GetCarHistoryPage":       members,
+			"CarContract:GetCarsWithPagination":   members,
+			"CarContract:GetMatchingOrders":       collectionMembers,
+			"CarContract:MatchOrder":              manufacturers,
+			"CarContract:RunMatching":             manufacturers,
+			"CarContract:GetMatchDecisions":       collectionMembers,
+			"CarContract:SetAutoMatch":            admins,
+			"CarContract:SetDealerPriority":       admins,
+			"CarContract:SetOrderRetention":       admins,
+			"CarContract:RegisterCar":             mvds,
+			"CarContract:ShipCar":                 manufacturers,
+			"CarContract:SellCar":                 dealers,
+			"CarContract:ScrapCar":                anyOf(manufacturerMSPs, mvdMSPs),
+			"CarContract:ProposeTransfer":         members,
+			"CarContract:AcceptTransfer":          members,
+			"CarContract:CancelTransfer":          members,
+			"CarContract:GetPendingTransfer":      members,
+			"CarContract:GetPendingTransfers":     members,
+			"CarContract:SetCarEndorsementPolicy": members,
+			"CarContract:GetCarEndorsementPolicy": members,
+			"CarContract:QueryCars":               members,
+			"CarContract:AttachDocument":          members,
+			"CarContract:PutCarPayload":           members,
+			"CarContract:GetCarPayload":           members,
+			"CarContract:GetCarPayloadManifest":   members,
+			"CarContract:SetStrictVIN":            admins,
+			"CarContract:SetCompression":          admins,
+			"CarContract:GetChaincodeConfig":      members,
+			"CarContract:GetCarAsOf":              members,
+			"CarContract:SetStateEncoding":        admins,
+			"CarContract:MigrateStateEncoding":    admins,
+			"CarContract:GetCarByPlate":           members,
+			"CarContract:ReplateCar":              mvds,
+			"CarContract:DeregisterCar":           mvds,
+			"CarContract:GetCarOwner":             mvds,
+			"CarContract:ProveOwnership":          members,
+			"CarContract:MigrateCarOwner":         mvds,
+			"CarContract:IssueRecall":             manufacturers,
+			"CarContract:GetRecall":               members,
+			"CarContract:GetOpenRecallsForCar":    members,
+			"CarContract:ListAffectedCars":        members,
+			"CarContract:CloseRecallForCar":       members,
+			// Not a transaction: changes to the endorsement policy of a car the client does not own
+			"CarContract:ChangeAnyEndorsementPolicy": admins,
+
+			"OrderContract:OrderExists":            collectionMembers,
+			"OrderContract:CreateOrder":            dealers,
+			"OrderContract:ReadOrder":              collectionMembers,
+			"OrderContract:DeleteOrder":            dealers,
+			"OrderContract:GetAllOrders":           collectionMembers,
+			"OrderContract:GetOrdersByRange":       collectionMembers,
+			"OrderContract:MigrateOrderEncoding":   admins,
+			"OrderContract:CancelOrder":            dealers,
+			"OrderContract:ExpireOrder":            collectionMembers,
+			"OrderContract:FulfillOrder":           dealers,
+			"OrderContract:GetOrderHistory":        collectionMembers,
+			"OrderContract:RebuildOrderBook":       admins,
+			"OrderContract:VerifyOrder":            members,
+			"OrderContract:PurgeOrder":             dealers,
+			"OrderContract:GetOrdersPastRetention": dealers,
+			// Not a transaction: changes to orders placed before their creator was recorded
+			"OrderContract:ChangeLegacyOrder": dealerAdmins,
+			// Not a transaction: the order history of every dealer of the client's organisation
+			"OrderContract:ViewOrganisationOrders": dealerAdmins,
+
+			"AccessContract:Init":             members,
+			"AccessContract:GetAccessPolicy":  members,
+			"AccessContract:SetAccessRule":    admins,
+			"AccessContract:RemoveAccessRule": admins,
+		},
+	}
+}
+
+func accessPolicyKey(ctx contractapi.TransactionContextInterface) (string, error) {
+	key, err := ctx.GetStub().CreateCompositeKey(accessPolicyKeyPrefix, []string{"policy"})
+	if err != nil {
+		return "", errorf(CodeInternal, "could not create the access policy key. %s", err)
+	}
+	return key, nil
+}
+
+// readAccessPolicy returns the policy stored on the ledger, or nil when none has been stored
+func readAccessPolicy(ctx contractapi.TransactionContextInterface) (*AccessPolicy, error) {
+	key, err := accessPolicyKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bytes, err := ctx.GetStub().GetState(key)
+	if err != nil {
+		return nil, errorf(CodeInternal, "failed to read from world state: %v", err)
+	}
+	if len(bytes) == 0 {
+		return nil, nil
+	}
+
+	var policy AccessPolicy
+	err = json.Unmarshal(bytes, &policy)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not unmarshal world state data to type AccessPolicy")
+	}
+	return &policy, nil
+}
+
+func writeAccessPolicy(ctx contractapi.TransactionContextInterface, policy *AccessPolicy) error {
+	key, err := accessPolicyKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	bytes, _ := json.Marshal(policy)
+	err = ctx.GetStub().PutState(key, bytes)
+	if err != nil {
+		return errorf(CodeInternal, "could not write the access policy. %s", err)
+	}
+	return nil
+}
+
+// accessRule returns the rule for a function, preferring the one stored on the ledger
+func accessRule(ctx contractapi.TransactionContextInterface, function string) (AccessRule, error) {
+	policy, err := readAccessPolicy(ctx)
+	if err != nil {
+		return AccessRule{}, err
+	}
+
+	if policy != nil {
+		if rule, ok := policy.Rules[function]; ok {
+			return rule, nil
+		}
+	}
+
+	rule, ok := defaultAccessPolicy().Rules[function]
+	if !ok {
+		return AccessRule{}, errorf(CodeInternal, "no access rule is defined for %s", function)
+	}
+	return rule, nil
+}
+
+// checkAccess fails unless the client identity satisfies the access rule for the function
+func checkAccess(ctx contractapi.TransactionContextInterface, function string) error {
+	rule, err := accessRule(ctx, function)
+	if err != nil {
+		return err
+	}
+
+	clientOrgID, err := ctx.GetClientIdentity().GetMSPID()
+	if err != nil {
+		return errorf(CodeInternal, "could not fetch client identity. %s", err)
+	}
+
+	allowed := false
+	for _, mspID := range rule.MSPIDs {
+		if mspID == clientOrgID {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return errorf(CodeUnauthorized, "user under following MSPID: %v can't perform this action", clientOrgID)
+	}
+
+	names := make([]string, 0, len(rule.Attributes))
+	for name := range rule.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		expected := rule.Attributes[name]
+		value, found, err := ctx.GetClientIdentity().GetAttributeValue(name)
+		if err != nil {
+			return errorf(CodeInternal, "failed to get attribute '%s': %v", name, err)
+		}
+		if !found || value != expected {
+			return errorf(CodeUnauthorized, "unauthorized user: must have '%s=%s'", name, expected)
+		}
+	}
+
+	return nil
+}
+
+// hasAccess reports whether the client identity satisfies the access rule for the function
+func hasAccess(ctx contractapi.TransactionContextInterface, function string) (bool, error) {
+	err := checkAccess(ctx, function)
+	var contractErr *ContractError
+	if errors.As(err, &contractErr) && contractErr.Code == CodeUnauthorized {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -0,0 +1,221 @@
+package contracts
+
+import (
+	"fmt"
+	"time"
+
+	"kbaauto/query"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+// ArchiveInfo records why, when and by whom a car was archived
+type ArchiveInfo struct {
+	Reason     string    `json:"reason"`
+	ArchivedBy *Identity `json:"archivedBy"`
+	ArchivedAt string    `json:"archivedAt"`
+}
+
+// unarchivedCars drops the archived cars from a query result
+func unarchivedCars(cars []*Car) []*Car {
+	visible := []*Car{}
+	for _, car := range cars {
+		if car.Archive == nil {
+			visible = append(visible, car)
+		}
+	}
+	return visible
+}
+
+// readActiveCar reads a car that is about to change. Archived cars are read-only until they are restored.
+func (c *CarContract) readActiveCar(ctx contractapi.TransactionContextInterface, carID string) (*Car, error) {
+	car, err := c.readCar(ctx, carID)
+	if err != nil {
+		return nil, err
+	}
+	if car.Archive != nil {
+		return nil, errorf(CodeConflict, "the car %s is archived and cannot be changed", carID)
+	}
+	return car, nil
+}
+
+// purgeCarRecords removes the keys kept beside a car: its payload manifests and chunks, a pending
+// transfer and its recall remedies. Documents are part of the car value and go with it.
+func purgeCarRecords(ctx contractapi.TransactionContextInterface, carID string) error {
+	for _, prefix := range []string{payloadKeyPrefix, payloadChunkKeyPrefix, transferKeyPrefix, recallCarKeyPrefix} {
+		resultsIterator, err := ctx.GetStub().GetStateByPartialCompositeKey(prefix, []string{carID})
+		if err != nil {
+			return errorf(CodeInternal, "could not fetch the %s records of car %s. %s", prefix, carID, err)
+		}
+
+		var keys []string
+		for resultsIterator.HasNext() {
+			queryResult, err := resultsIterator.Next()
+			if err != nil {
+				resultsIterator.Close()
+				return errorf(CodeInternal, "could not fetch the details of the result iterator. %s", err)
+			}
+			keys = append(keys, queryResult.Key)
+		}
+		resultsIterator.Close()
+
+		for _, key := range keys {
+			err = ctx.GetStub().DelState(key)
+			if err != nil {
+				return errorf(CodeInternal, "could not purge the %s records of car %s. %s", prefix, carID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// archiveCar hides a car from the listings while keeping it readable in the world state
+func (c *CarContract) archiveCar(ctx contractapi.TransactionContextInterface, car *Car, reason string) error {
+	if reason == "" {
+		return errorf(CodeInvalidArgument, "a reason is required to archive the car %s", car.CarId)
+	}
+	if car.Archive != nil {
+		return errorf(CodeConflict, "the car %s is already archived", car.CarId)
+	}
+
+	err := requireCarStatus(car, "archived", StatusManufactured, StatusScrapped)
+	if err != nil {
+		return err
+	}
+
+	actor, err := clientIdentity(ctx)
+	if err != nil {
+		return err
+	}
+
+	now, err := txTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	car.Archive = &ArchiveInfo{
+		Reason:     reason,
+		ArchivedBy: &actor,
+		ArchivedAt: now.Format(time.RFC3339),
+	}
+	car.Version++
+	bytes, err := marshalCar(ctx, car)
+	if err != nil {
+		return err
+	}
+	err = ctx.GetStub().PutState(car.CarId, bytes)
+	if err != nil {
+		return errorf(CodeInternal, "could not archive car. %s", err)
+	}
+	return nil
+}
+
+// ArchiveCar marks a car archived so it no longer shows up in the car listings
+func (c *CarContract) ArchiveCar(ctx contractapi.TransactionContextInterface, carID string, reason string) (string, error) {
+	err := checkAccess(ctx, "CarContract:ArchiveCar")
+	if err != nil {
+		return "", err
+	}
+
+	car, err := c.readCar(ctx, carID)
+	if err != nil {
+		return "", err
+	}
+
+	err = c.archiveCar(ctx, car, reason)
+	if err != nil {
+		return "", err
+	}
+
+	err = emitCarEvent(ctx, EventCarArchived, carID, car)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("car with id %v is archived.", carID), nil
+}
+
+// RestoreCar returns an archived car to the car listings
+func (c *CarContract) RestoreCar(ctx contractapi.TransactionContextInterface, carID string) (string, error) {
+	err := checkAccess(ctx, "CarContract:RestoreCar")
+	if err != nil {
+		return "", err
+	}
+
+	car, err := c.readCar(ctx, carID)
+	if err != nil {
+		return "", err
+	}
+	if car.Archive == nil {
+		return "", errorf(CodeConflict, "the car %s is not archived", carID)
+	}
+
+	car.Archive = nil
+	car.Version++
+	bytes, err := marshalCar(ctx, car)
+	if err != nil {
+		return "", err
+	}
+	err = ctx.GetStub().PutState(carID, bytes)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not restore car. %s", err)
+	}
+
+	err = emitCarEvent(ctx, EventCarRestored, carID, car)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("car with id %v is restored.", carID), nil
+}
+
+// PurgeCar removes an archived car from the world state, along with its payloads, pending transfer
+// and recall remedies. Its versions stay in the car history.
+func (c *CarContract) PurgeCar(ctx contractapi.TransactionContextInterface, carID string) (string, error) {
+	err := checkAccess(ctx, "CarContract:PurgeCar")
+	if err != nil {
+		return "", err
+	}
+
+	car, err := c.readCar(ctx, carID)
+	if err != nil {
+		return "", err
+	}
+	if car.Archive == nil {
+		return "", errorf(CodeConflict, "the car %s must be archived before it is purged", carID)
+	}
+
+	err = purgeCarRecords(ctx, carID)
+	if err != nil {
+		return "", err
+	}
+
+	err = ctx.GetStub().DelState(carID)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not purge car. %s", err)
+	}
+
+	err = emitCarEvent(ctx, EventCarPurged, carID, nil)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("car with id %v is purged from the world state.", carID), nil
+}
+
+// GetArchivedCars returns the cars that are archived but not purged
+func (c *CarContract) GetArchivedCars(ctx contractapi.TransactionContextInterface) ([]*Car, error) {
+	err := checkAccess(ctx, "CarContract:GetArchivedCars")
+	if err != nil {
+		return nil, err
+	}
+
+	queryString, err := query.New().Eq("assetType", "car").Exists("archive", true).String()
+	if err != nil {
+		return nil, errorf(CodeInternal, "%s", err)
+	}
+
+	resultsIterator, err := ctx.GetStub().GetQueryResult(queryString)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not fetch the query result. %s", err)
+	}
+	defer resultsIterator.Close()
+	return carResultIteratorFunction(resultsIterator)
+}
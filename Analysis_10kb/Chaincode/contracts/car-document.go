@@ -0,0 +1,86 @@
+package contracts
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"kbaauto/validate"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+// Document is a file kept off-chain, such as an invoice or an inspection report, anchored to a
+// car by the SHA-256 hash of its content
+type Document struct {
+	Name       string    `json:"name" validate:"required,max=128,charset=name"`
+	SHA256     string    `json:"sha256" validate:"required,len=64,charset=hex"`
+	MediaType  string    `json:"mediaType" validate:"required,max=128,mediatype"`
+	Size       int64     `json:"size"`
+	UploadedBy *Identity `json:"uploadedBy"`
+	UploadedAt string    `json:"uploadedAt"`
+}
+
+// AttachDocument records the hash, media type and size of a document on the car. The content
+// itself stays off-chain; anyone holding a copy can check it against the recorded hash.
+func (c *CarContract) AttachDocument(ctx contractapi.TransactionContextInterface, carID string, name string, sha256 string, mediaType string, size int64) (string, error) {
+	err := checkAccess(ctx, "CarContract:AttachDocument")
+	if err != nil {
+		return "", err
+	}
+
+	document := &Document{
+		Name:      name,
+		SHA256:    strings.ToLower(sha256),
+		MediaType: mediaType,
+		Size:      size,
+	}
+	err = validate.Struct(document)
+	if err != nil {
+		return "", errorf(CodeInvalidArgument, "invalid document. %s", err)
+	}
+	if size <= 0 {
+		return "", errorf(CodeInvalidArgument, "invalid document. size must be greater than 0")
+	}
+
+	car, err := c.readActiveCar(ctx, carID)
+	if err != nil {
+		return "", err
+	}
+
+	for _, attached := range car.Documents {
+		if attached.SHA256 == document.SHA256 {
+			return "", errorf(CodeAlreadyExists, "the document %s is already attached to the car %s", document.SHA256, carID)
+		}
+	}
+
+	uploader, err := clientIdentity(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	now, err := txTime(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	document.UploadedBy = &uploader
+	document.UploadedAt = now.Format(time.RFC3339)
+	car.Documents = append(car.Documents, document)
+
+	car.Version++
+	bytes, err := marshalCar(ctx, car)
+	if err != nil {
+		return "", err
+	}
+	err = ctx.GetStub().PutState(carID, bytes)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not attach the document. %s", err)
+	}
+
+	err = emitCarEvent(ctx, EventCarUpdated, carID, car)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("document %v attached to car %v", document.SHA256, carID), nil
+}
@@ -0,0 +1,119 @@
+package contracts
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+// patchableCarFields maps the fields PatchCar can change to their setters
+var patchableCarFields = map[string]func(car *Car, value string){
+	"make":              func(car *Car, value string) { car.Make = value },
+	"model":             func(car *Car, value string) { car.Model = value },
+	"color":             func(car *Car, value string) { car.Color = value },
+	"dateOfManufacture": func(car *Car, value string) { car.DateOfManufacture = value },
+}
+
+// protectedCarFields are changed by the lifecycle and ownership transactions only
+var protectedCarFields = map[string]bool{
+	"assetType":          true,
+	"carId":              true,
+	"ownedBy":            true,
+	"owner":              true,
+	"status":             true,
+	"registeredOwner":    true,
+	"ownerCommitment":    true,
+	"registrationNumber": true,
+	"version":            true,
+}
+
+// parseCarPatch decodes a field mask such as {"color":"Blue"} into field values
+func parseCarPatch(patchJSON string) (map[string]string, error) {
+	var raw map[string]json.RawMessage
+	err := json.Unmarshal([]byte(patchJSON), &raw)
+	if err != nil {
+		return nil, errorf(CodeInvalidArgument, "could not parse the car patch. %s", err)
+	}
+	if len(raw) == 0 {
+		return nil, errorf(CodeInvalidArgument, "the car patch has no fields")
+	}
+
+	patch := map[string]string{}
+	for field, value := range raw {
+		if protectedCarFields[field] {
+			return nil, errorf(CodeInvalidArgument, "the field %s cannot be patched", field)
+		}
+		if _, ok := patchableCarFields[field]; !ok {
+			return nil, errorf(CodeInvalidArgument, "unknown car field %s", field)
+		}
+
+		var text string
+		err = json.Unmarshal(value, &text)
+		if err != nil {
+			return nil, errorf(CodeInvalidArgument, "the field %s must be a string", field)
+		}
+		patch[field] = text
+	}
+	return patch, nil
+}
+
+// PatchCar changes only the fields in the JSON field mask. A non-zero expectedVersion must match the
+// version of the car, so a client can detect that someone else changed the car since it read it.
+func (c *CarContract) PatchCar(ctx contractapi.TransactionContextInterface, carID string, patchJSON string, expectedVersion int64) (string, error) {
+	err := checkAccess(ctx, "CarContract:PatchCar")
+	if err != nil {
+		return "", err
+	}
+
+	patch, err := parseCarPatch(patchJSON)
+	if err != nil {
+		return "", err
+	}
+
+	car, err := c.readActiveCar(ctx, carID)
+	if err != nil {
+		return "", err
+	}
+
+	if expectedVersion != 0 && car.Version != expectedVersion {
+		return "", errorf(CodeConflict, "the car %s is at version %d, expected %d", carID, car.Version, expectedVersion)
+	}
+
+	err = requireCarStatus(car, "patched", StatusManufactured, StatusInTransit, StatusAtDealer, StatusSold, StatusRegistered)
+	if err != nil {
+		return "", err
+	}
+
+	fields := make([]string, 0, len(patch))
+	for field, value := range patch {
+		patchableCarFields[field](car, value)
+		fields = append(fields, field)
+	}
+
+	err = validateCar(car, fields...)
+	if err != nil {
+		return "", err
+	}
+
+	err = checkCarIdentity(ctx, car)
+	if err != nil {
+		return "", err
+	}
+
+	car.Version++
+	bytes, err := marshalCar(ctx, car)
+	if err != nil {
+		return "", err
+	}
+	err = ctx.GetStub().PutState(carID, bytes)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not update car. %s", err)
+	}
+
+	err = emitCarEvent(ctx, EventCarUpdated, carID, car)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("successfully patched car %v to version %v", carID, car.Version), nil
+}
@@ -0,0 +1,236 @@
+package contracts
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"kbaauto/validate"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+const (
+	payloadKeyPrefix      string = "payload"
+	payloadChunkKeyPrefix string = "payloadchunk"
+
+	// payloadChunkSize is the largest value a chunked payload writes to one key
+	payloadChunkSize = 8 * 1024
+)
+
+// PayloadManifest describes a payload stored on-chain for a car. The content is split into
+// Chunks keys of at most ChunkSize bytes and verified against SHA256 when it is read back.
+// Encoded is false for payloads stored before chunks carried an encoding header byte.
+type PayloadManifest struct {
+	AssetType string `json:"assetType"`
+	CarId     string `json:"carId"`
+	Name      string `json:"name" validate:"required,max=64,charset=id"`
+	Size      int    `json:"size"`
+	ChunkSize int    `json:"chunkSize"`
+	Chunks    int    `json:"chunks"`
+	SHA256    string `json:"sha256"`
+	StoredAt  string `json:"storedAt"`
+	Encoded   bool   `json:"encoded,omitempty" metadata:",optional"`
+}
+
+func payloadKey(ctx contractapi.TransactionContextInterface, carID string, name string) (string, error) {
+	key, err := ctx.GetStub().CreateCompositeKey(payloadKeyPrefix, []string{carID, name})
+	if err != nil {
+		return "", errorf(CodeInternal, "could not create the payload key. %s", err)
+	}
+	return key, nil
+}
+
+// payloadChunkKey zero pads the chunk index so the chunks of a payload sort in order
+func payloadChunkKey(ctx contractapi.TransactionContextInterface, carID string, name string, index int) (string, error) {
+	key, err := ctx.GetStub().CreateCompositeKey(payloadChunkKeyPrefix, []string{carID, name, fmt.Sprintf("%06d", index)})
+	if err != nil {
+		return "", errorf(CodeInternal, "could not create the payload chunk key. %s", err)
+	}
+	return key, nil
+}
+
+// readPayloadManifest returns the manifest of a payload, or nil when the car has no payload with that name
+func readPayloadManifest(ctx contractapi.TransactionContextInterface, carID string, name string) (*PayloadManifest, error) {
+	key, err := payloadKey(ctx, carID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	bytes, err := ctx.GetStub().GetState(key)
+	if err != nil {
+		return nil, errorf(CodeInternal, "failed to read from world state: %v", err)
+	}
+	if bytes == nil {
+		return nil, nil
+	}
+
+	var manifest PayloadManifest
+	err = json.Unmarshal(bytes, &manifest)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not unmarshal world state data to type PayloadManifest")
+	}
+	return &manifest, nil
+}
+
+// PutCarPayload stores an opaque payload for a car, replacing any payload with the same name. A chunked
+// payload is split into keys of at most 8 KB; otherwise the whole payload is written to a single key.
+func (c *CarContract) PutCarPayload(ctx contractapi.TransactionContextInterface, carID string, name string, payload string, chunked bool) (string, error) {
+	err := checkAccess(ctx, "CarContract:PutCarPayload")
+	if err != nil {
+		return "", err
+	}
+
+	if len(payload) == 0 {
+		return "", errorf(CodeInvalidArgument, "the payload %s is empty", name)
+	}
+
+	manifest := &PayloadManifest{
+		AssetType: "payloadManifest",
+		CarId:     carID,
+		Name:      name,
+		Size:      len(payload),
+		ChunkSize: len(payload),
+		Encoded:   true,
+	}
+	err = validate.Struct(manifest)
+	if err != nil {
+		return "", errorf(CodeInvalidArgument, "invalid payload. %s", err)
+	}
+	if chunked {
+		manifest.ChunkSize = payloadChunkSize
+	}
+	manifest.Chunks = (manifest.Size + manifest.ChunkSize - 1) / manifest.ChunkSize
+
+	_, err = c.readActiveCar(ctx, carID)
+	if err != nil {
+		return "", err
+	}
+
+	// Chunks left over from a larger payload under the same name are removed
+	previous, err := readPayloadManifest(ctx, carID, name)
+	if err != nil {
+		return "", err
+	}
+	previousChunks := 0
+	if previous != nil {
+		previousChunks = previous.Chunks
+	}
+
+	threshold, err := compressionThreshold(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for index := 0; index < manifest.Chunks || index < previousChunks; index++ {
+		chunkKey, err := payloadChunkKey(ctx, carID, name, index)
+		if err != nil {
+			return "", err
+		}
+
+		if index >= manifest.Chunks {
+			err = ctx.GetStub().DelState(chunkKey)
+			if err != nil {
+				return "", errorf(CodeInternal, "could not remove the payload chunk %d. %s", index, err)
+			}
+			continue
+		}
+
+		end := (index + 1) * manifest.ChunkSize
+		if end > manifest.Size {
+			end = manifest.Size
+		}
+		chunk, err := encodeValue([]byte(payload[index*manifest.ChunkSize:end]), threshold)
+		if err != nil {
+			return "", err
+		}
+		err = ctx.GetStub().PutState(chunkKey, chunk)
+		if err != nil {
+			return "", errorf(CodeInternal, "could not write the payload chunk %d. %s", index, err)
+		}
+	}
+
+	now, err := txTime(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(payload))
+	manifest.SHA256 = hex.EncodeToString(sum[:])
+	manifest.StoredAt = now.Format(time.RFC3339)
+
+	key, err := payloadKey(ctx, carID, name)
+	if err != nil {
+		return "", err
+	}
+
+	bytes, _ := json.Marshal(manifest)
+	err = ctx.GetStub().PutState(key, bytes)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not write the payload manifest. %s", err)
+	}
+	return fmt.Sprintf("payload %v of car %v stored in %v chunks", name, carID, manifest.Chunks), nil
+}
+
+// GetCarPayloadManifest returns the size, chunking and hash of a payload
+func (c *CarContract) GetCarPayloadManifest(ctx contractapi.TransactionContextInterface, carID string, name string) (*PayloadManifest, error) {
+	err := checkAccess(ctx, "CarContract:GetCarPayloadManifest")
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := readPayloadManifest(ctx, carID, name)
+	if err != nil {
+		return nil, err
+	} else if manifest == nil {
+		return nil, errorf(CodeNotFound, "the car %s has no payload %s", carID, name)
+	}
+	return manifest, nil
+}
+
+// GetCarPayload reassembles a payload from its chunks and checks it against the manifest hash
+func (c *CarContract) GetCarPayload(ctx contractapi.TransactionContextInterface, carID string, name string) (string, error) {
+	err := checkAccess(ctx, "CarContract:GetCarPayload")
+	if err != nil {
+		return "", err
+	}
+
+	manifest, err := readPayloadManifest(ctx, carID, name)
+	if err != nil {
+		return "", err
+	} else if manifest == nil {
+		return "", errorf(CodeNotFound, "the car %s has no payload %s", carID, name)
+	}
+
+	payload := make([]byte, 0, manifest.Size)
+	for index := 0; index < manifest.Chunks; index++ {
+		chunkKey, err := payloadChunkKey(ctx, carID, name, index)
+		if err != nil {
+			return "", err
+		}
+
+		chunk, err := ctx.GetStub().GetState(chunkKey)
+		if err != nil {
+			return "", errorf(CodeInternal, "failed to read from world state: %v", err)
+		}
+		if chunk == nil {
+			return "", errorf(CodeInternal, "the payload %s of car %s is missing chunk %d", name, carID, index)
+		}
+		// Legacy chunks hold the raw payload, which may itself start with a header byte
+		if manifest.Encoded {
+			chunk, err = decodeValue(chunk)
+			if err != nil {
+				return "", err
+			}
+		}
+		payload = append(payload, chunk...)
+	}
+
+	sum := sha256.Sum256(payload)
+	if len(payload) != manifest.Size || hex.EncodeToString(sum[:]) != manifest.SHA256 {
+		return "", errorf(CodeInternal, "the payload %s of car %s does not match its manifest", name, carID)
+	}
+	return string(payload), nil
+}
@@ -0,0 +1,443 @@
+package contracts
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"kbaauto/query"
+	"kbaauto/validate"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+const (
+	recallKeyPrefix    string = "recall"
+	recallCarKeyPrefix string = "carrecall"
+
+	// maxRecallCars bounds the writes of one IssueRecall; larger campaigns are split into several recalls
+	maxRecallCars = 1000
+)
+
+// Remediation states of a recalled car
+const (
+	RecallOpen   = "Open"
+	RecallClosed = "Closed"
+)
+
+// Recall is a manufacturer campaign over the cars of a make and model built in a date range, or
+// over an explicit list of cars
+type Recall struct {
+	AssetType        string    `json:"assetType"`
+	RecallID         string    `json:"recallId" validate:"required,max=64,charset=id"`
+	Make             string    `json:"make,omitempty" metadata:",optional" validate:"max=64,charset=name"`
+	Model            string    `json:"model,omitempty" metadata:",optional" validate:"max=64,charset=name"`
+	ManufacturedFrom string    `json:"manufacturedFrom,omitempty" metadata:",optional" validate:"date"`
+	ManufacturedTo   string    `json:"manufacturedTo,omitempty" metadata:",optional" validate:"date"`
+	CarIds           []string  `json:"carIds,omitempty" metadata:",optional" validate:"required,max=64,charset=id"`
+	Description      string    `json:"description" validate:"required,max=1024"`
+	AffectedCars     int       `json:"affectedCars"`
+	IssuedBy         *Identity `json:"issuedBy"`
+	IssuedAt         string    `json:"issuedAt"`
+}
+
+// RecallRemedy records the remediation of one car under a recall. It is stored under the car so
+// the open recalls of a car are found by key.
+type RecallRemedy struct {
+	AssetType string    `json:"assetType"`
+	RecallID  string    `json:"recallId"`
+	CarId     string    `json:"carId"`
+	Status    string    `json:"status"`
+	ClosedBy  *Identity `json:"closedBy,omitempty" metadata:",optional"`
+	ClosedAt  string    `json:"closedAt,omitempty" metadata:",optional"`
+}
+
+// AffectedCarsResult is one page of the cars affected by a recall
+type AffectedCarsResult struct {
+	Records             []*RecallRemedy `json:"records"`
+	FetchedRecordsCount int32           `json:"fetchedRecordsCount"`
+	Bookmark            string          `json:"bookmark"`
+}
+
+func recallKey(ctx contractapi.TransactionContextInterface, recallID string) (string, error) {
+	key, err := ctx.GetStub().CreateCompositeKey(recallKeyPrefix, []string{recallID})
+	if err != nil {
+		return "", errorf(CodeInternal, "could not create the recall key. %s", err)
+	}
+	return key, nil
+}
+
+func recallCarKey(ctx contractapi.TransactionContextInterface, carID string, recallID string) (string, error) {
+	key, err := ctx.GetStub().CreateCompositeKey(recallCarKeyPrefix, []string{carID, recallID})
+	if err != nil {
+		return "", errorf(CodeInternal, "could not create the recall key. %s", err)
+	}
+	return key, nil
+}
+
+func readRecall(ctx contractapi.TransactionContextInterface, recallID string) (*Recall, error) {
+	key, err := recallKey(ctx, recallID)
+	if err != nil {
+		return nil, err
+	}
+
+	bytes, err := ctx.GetStub().GetState(key)
+	if err != nil {
+		return nil, errorf(CodeInternal, "failed to read from world state: %v", err)
+	}
+	if bytes == nil {
+		return nil, errorf(CodeNotFound, "the recall %s does not exist", recallID)
+	}
+
+	var recall Recall
+	err = json.Unmarshal(bytes, &recall)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not unmarshal world state data to type Recall")
+	}
+	return &recall, nil
+}
+
+// uniqueCarIDs drops repeated car IDs, keeping the first occurrence of each
+func uniqueCarIDs(carIDs []string) []string {
+	seen := make(map[string]bool, len(carIDs))
+	unique := []string{}
+	for _, carID := range carIDs {
+		if !seen[carID] {
+			seen[carID] = true
+			unique = append(unique, carID)
+		}
+	}
+	return unique
+}
+
+// covers returns true when a car selected by make and model falls in the recall
+func (r *Recall) covers(car *Car) bool {
+	if car.Make != r.Make || car.Model != r.Model {
+		return false
+	}
+	if r.ManufacturedFrom != "" && car.DateOfManufacture < r.ManufacturedFrom {
+		return false
+	}
+	if r.ManufacturedTo != "" && car.DateOfManufacture > r.ManufacturedTo {
+		return false
+	}
+	return true
+}
+
+// recalledCars returns the cars a recall applies to. Archived and scrapped cars need no remediation
+// and are left out.
+func (c *CarContract) recalledCars(ctx contractapi.TransactionContextInterface, recall *Recall) ([]*Car, error) {
+	var cars []*Car
+	if len(recall.CarIds) > 0 {
+		for _, carID := range recall.CarIds {
+			car, err := c.readCar(ctx, carID)
+			if err != nil {
+				return nil, err
+			}
+			cars = append(cars, car)
+		}
+	} else {
+		q, err := carQuery(CarFilter{
+			Make:             recall.Make,
+			Model:            recall.Model,
+			ManufacturedFrom: recall.ManufacturedFrom,
+			ManufacturedTo:   recall.ManufacturedTo,
+		}, "", "")
+		if err != nil {
+			return nil, err
+		}
+		queryString, err := q.String()
+		if err != nil {
+			return nil, errorf(CodeInternal, "%s", err)
+		}
+
+		resultsIterator, err := ctx.GetStub().GetQueryResult(queryString)
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not get the car records. %s", err)
+		}
+		defer resultsIterator.Close()
+
+		matching, err := carResultIteratorFunction(resultsIterator)
+		if err != nil {
+			return nil, err
+		}
+		for _, car := range matching {
+			if recall.covers(car) {
+				cars = append(cars, car)
+			}
+		}
+	}
+
+	affected := []*Car{}
+	for _, car := range unarchivedCars(cars) {
+		if car.Status != StatusScrapped {
+			affected = append(affected, car)
+		}
+	}
+	if len(affected) > maxRecallCars {
+		return nil, errorf(CodeInvalidArgument, "the recall affects more than %d cars, split it into smaller recalls", maxRecallCars)
+	}
+	return affected, nil
+}
+
+// IssueRecall opens a recall over the cars of a make and model manufactured between two dates
+// (either may be empty), or over the cars in carIDsJSON, a JSON array of car IDs. Every affected
+// car gets an open remedy that dealers close with CloseRecallForCar.
+func (c *CarContract) IssueRecall(ctx contractapi.TransactionContextInterface, recallID string, make string, model string, manufacturedFrom string, manufacturedTo string, carIDsJSON string, description string) (*Recall, error) {
+	err := checkAccess(ctx, "CarContract:IssueRecall")
+	if err != nil {
+		return nil, err
+	}
+
+	recall := &Recall{
+		AssetType:        "recall",
+		RecallID:         recallID,
+		Make:             make,
+		Model:            model,
+		ManufacturedFrom: manufacturedFrom,
+		ManufacturedTo:   manufacturedTo,
+		Description:      description,
+	}
+	if carIDsJSON != "" {
+		err = json.Unmarshal([]byte(carIDsJSON), &recall.CarIds)
+		if err != nil {
+			return nil, errorf(CodeInvalidArgument, "could not parse the car list. %s", err)
+		}
+		recall.CarIds = uniqueCarIDs(recall.CarIds)
+	}
+
+	byModel := make != "" || model != "" || manufacturedFrom != "" || manufacturedTo != ""
+	if byModel == (len(recall.CarIds) > 0) || (byModel && (make == "" || model == "")) {
+		return nil, errorf(CodeInvalidArgument, "a recall needs either a make and model, with an optional manufacture date range, or a car list")
+	}
+
+	err = validate.Struct(recall)
+	if err != nil {
+		return nil, errorf(CodeInvalidArgument, "invalid recall. %s", err)
+	}
+
+	key, err := recallKey(ctx, recallID)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := ctx.GetStub().GetState(key)
+	if err != nil {
+		return nil, errorf(CodeInternal, "failed to read from world state: %v", err)
+	}
+	if existing != nil {
+		return nil, errorf(CodeAlreadyExists, "the recall %s already exists", recallID)
+	}
+
+	cars, err := c.recalledCars(ctx, recall)
+	if err != nil {
+		return nil, err
+	}
+	if len(cars) == 0 {
+		return nil, errorf(CodeConflict, "the recall %s affects no cars", recallID)
+	}
+
+	for _, car := range cars {
+		remedyKey, err := recallCarKey(ctx, car.CarId, recallID)
+		if err != nil {
+			return nil, err
+		}
+
+		bytes, _ := json.Marshal(RecallRemedy{AssetType: "recallCar", RecallID: recallID, CarId: car.CarId, Status: RecallOpen})
+		err = ctx.GetStub().PutState(remedyKey, bytes)
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not record the recall of car %s. %s", car.CarId, err)
+		}
+	}
+
+	issuedBy, err := clientIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now, err := txTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+	recall.AffectedCars = len(cars)
+	recall.IssuedBy = &issuedBy
+	recall.IssuedAt = now.Format(time.RFC3339)
+
+	bytes, _ := json.Marshal(recall)
+	err = ctx.GetStub().PutState(key, bytes)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not create the recall %s. %s", recallID, err)
+	}
+
+	err = emitRecallEvent(ctx, EventRecallIssued, recallID, "")
+	if err != nil {
+		return nil, err
+	}
+	return recall, nil
+}
+
+// GetRecall returns a recall
+func (c *CarContract) GetRecall(ctx contractapi.TransactionContextInterface, recallID string) (*Recall, error) {
+	err := checkAccess(ctx, "CarContract:GetRecall")
+	if err != nil {
+		return nil, err
+	}
+
+	return readRecall(ctx, recallID)
+}
+
+// GetOpenRecallsForCar returns the recalls whose remediation is still open for a car
+func (c *CarContract) GetOpenRecallsForCar(ctx contractapi.TransactionContextInterface, carID string) ([]*Recall, error) {
+	err := checkAccess(ctx, "CarContract:GetOpenRecallsForCar")
+	if err != nil {
+		return nil, err
+	}
+
+	resultsIterator, err := ctx.GetStub().GetStateByPartialCompositeKey(recallCarKeyPrefix, []string{carID})
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not get the recalls of car %s. %s", carID, err)
+	}
+	defer resultsIterator.Close()
+
+	recalls := []*Recall{}
+	for resultsIterator.HasNext() {
+		queryResult, err := resultsIterator.Next()
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not fetch the details of the result iterator. %s", err)
+		}
+
+		var remedy RecallRemedy
+		err = json.Unmarshal(queryResult.Value, &remedy)
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not unmarshal world state data to type RecallRemedy")
+		}
+		if remedy.Status != RecallOpen {
+			continue
+		}
+
+		recall, err := readRecall(ctx, remedy.RecallID)
+		if err != nil {
+			return nil, err
+		}
+		recalls = append(recalls, recall)
+	}
+	return recalls, nil
+}
+
+// ListAffectedCars returns one page of the cars affected by a recall, limited to one remediation
+// status unless status is empty
+func (c *CarContract) ListAffectedCars(ctx contractapi.TransactionContextInterface, recallID string, status string, pageSize int32, bookmark string) (*AffectedCarsResult, error) {
+	err := checkAccess(ctx, "CarContract:ListAffectedCars")
+	if err != nil {
+		return nil, err
+	}
+
+	if pageSize <= 0 || pageSize > maxCarPageSize {
+		return nil, errorf(CodeInvalidArgument, "the page size must be between 1 and %d", maxCarPageSize)
+	}
+
+	_, err = readRecall(ctx, recallID)
+	if err != nil {
+		return nil, err
+	}
+
+	q := query.New().Eq("assetType", "recallCar").Eq("recallId", recallID)
+	if status != "" {
+		if status != RecallOpen && status != RecallClosed {
+			return nil, errorf(CodeInvalidArgument, "unknown remediation status %s, expected %s or %s", status, RecallOpen, RecallClosed)
+		}
+		q.Eq("status", status)
+	}
+	queryString, err := q.String()
+	if err != nil {
+		return nil, errorf(CodeInternal, "%s", err)
+	}
+
+	resultsIterator, responseMetadata, err := ctx.GetStub().GetQueryResultWithPagination(queryString, pageSize, bookmark)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not get the affected cars. %s", err)
+	}
+	defer resultsIterator.Close()
+
+	remedies := []*RecallRemedy{}
+	for resultsIterator.HasNext() {
+		queryResult, err := resultsIterator.Next()
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not fetch the details of the result iterator. %s", err)
+		}
+
+		var remedy RecallRemedy
+		err = json.Unmarshal(queryResult.Value, &remedy)
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not unmarshal world state data to type RecallRemedy")
+		}
+		remedies = append(remedies, &remedy)
+	}
+
+	return &AffectedCarsResult{
+		Records:             remedies,
+		FetchedRecordsCount: responseMetadata.FetchedRecordsCount,
+		Bookmark:            responseMetadata.Bookmark,
+	}, nil
+}
+
+// CloseRecallForCar records that the remediation of a recall is done on a car. Only the owner of the
+// car, which is the dealer holding it once it is matched, can close it.
+func (c *CarContract) CloseRecallForCar(ctx contractapi.TransactionContextInterface, recallID string, carID string) (string, error) {
+	err := checkAccess(ctx, "CarContract:CloseRecallForCar")
+	if err != nil {
+		return "", err
+	}
+
+	key, err := recallCarKey(ctx, carID, recallID)
+	if err != nil {
+		return "", err
+	}
+
+	bytes, err := ctx.GetStub().GetState(key)
+	if err != nil {
+		return "", errorf(CodeInternal, "failed to read from world state: %v", err)
+	}
+	if bytes == nil {
+		return "", errorf(CodeNotFound, "the car %s is not affected by the recall %s", carID, recallID)
+	}
+
+	var remedy RecallRemedy
+	err = json.Unmarshal(bytes, &remedy)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not unmarshal world state data to type RecallRemedy")
+	}
+	if remedy.Status == RecallClosed {
+		return "", errorf(CodeConflict, "the recall %s is already closed for the car %s", recallID, carID)
+	}
+
+	closedBy, err := clientIdentity(ctx)
+	if err != nil {
+		return "", err
+	}
+	car, err := c.readCar(ctx, carID)
+	if err != nil {
+		return "", err
+	}
+	if car.Owner == nil || *car.Owner != closedBy {
+		return "", errorf(CodeUnauthorized, "only the owner of car %s can close its recalls", carID)
+	}
+
+	now, err := txTime(ctx)
+	if err != nil {
+		return "", err
+	}
+	remedy.Status = RecallClosed
+	remedy.ClosedBy = &closedBy
+	remedy.ClosedAt = now.Format(time.RFC3339)
+
+	bytes, _ = json.Marshal(remedy)
+	err = ctx.GetStub().PutState(key, bytes)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not close the recall %s for the car %s. %s", recallID, carID, err)
+	}
+
+	err = emitRecallEvent(ctx, EventRecallClosed, recallID, carID)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("recall %v is closed for car %v", recallID, carID), nil
+}
@@ -0,0 +1,79 @@
+package contracts
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// CarStatus is a stage in the car lifecycle
+type CarStatus string
+
+const (
+	StatusManufactured CarStatus = "Manufactured"
+	StatusInTransit    CarStatus = "InTransit"
+	StatusAtDealer     CarStatus = "AtDealer"
+	StatusSold         CarStatus = "Sold"
+	StatusRegistered   CarStatus = "Registered"
+	StatusScrapped     CarStatus = "Scrapped"
+)
+
+// carTransitions lists the stages a car may move to from each stage. A registered car goes back to
+// Sold when it is deregistered.
+var carTransitions = map[CarStatus][]CarStatus{
+	StatusManufactured: {StatusInTransit, StatusAtDealer, StatusScrapped},
+	StatusInTransit:    {StatusAtDealer, StatusScrapped},
+	StatusAtDealer:     {StatusSold, StatusScrapped},
+	StatusSold:         {StatusRegistered, StatusScrapped},
+	StatusRegistered:   {StatusSold, StatusScrapped},
+	StatusScrapped:     {},
+}
+
+// CanTransitionTo returns true when the lifecycle allows moving from s to next
+func (s CarStatus) CanTransitionTo(next CarStatus) bool {
+	for _, allowed := range carTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// UnmarshalJSON maps the free-text statuses written by earlier versions of the chaincode
+// onto the lifecycle stages
+func (s *CarStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch {
+	case value == "In Factory":
+		*s = StatusManufactured
+	case value == "assigned to a dealer":
+		*s = StatusAtDealer
+	case strings.HasPrefix(value, "Registered to"):
+		*s = StatusRegistered
+	default:
+		*s = CarStatus(value)
+	}
+	return nil
+}
+
+// transitionCar moves the car to the next stage or reports why it cannot
+func transitionCar(car *Car, next CarStatus) error {
+	if !car.Status.CanTransitionTo(next) {
+		return errorf(CodeConflict, "the car %s cannot move from %s to %s", car.CarId, car.Status, next)
+	}
+	car.Status = next
+	return nil
+}
+
+// requireCarStatus fails unless the car is in one of the given stages
+func requireCarStatus(car *Car, action string, statuses ...CarStatus) error {
+	for _, status := range statuses {
+		if car.Status == status {
+			return nil
+		}
+	}
+	return errorf(CodeConflict, "the car %s cannot be %s while %s", car.CarId, action, car.Status)
+}
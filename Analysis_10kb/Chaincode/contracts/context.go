@@ -0,0 +1,45 @@
+package contracts
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+// Identity names a client identity by its MSP and enrollment ID
+type Identity struct {
+	MSPID        string `json:"mspId"`
+	EnrollmentID string `json:"enrollmentId"`
+}
+
+// String returns the identity in mspId/enrollmentId form
+func (i Identity) String() string {
+	return fmt.Sprintf("%s/%s", i.MSPID, i.EnrollmentID)
+}
+
+// clientIdentity returns the identity of the client submitting the transaction
+func clientIdentity(ctx contractapi.TransactionContextInterface) (Identity, error) {
+	clientOrgID, err := ctx.GetClientIdentity().GetMSPID()
+	if err != nil {
+		return Identity{}, errorf(CodeInternal, "could not fetch client identity. %s", err)
+	}
+
+	val, ok, err := ctx.GetClientIdentity().GetAttributeValue("hf.EnrollmentID")
+	if err != nil {
+		return Identity{}, errorf(CodeInternal, "could not fetch Attribute value. %s", err)
+	} else if !ok {
+		return Identity{}, errorf(CodeUnauthorized, "client identity has no enrollment ID")
+	}
+
+	return Identity{MSPID: clientOrgID, EnrollmentID: val}, nil
+}
+
+// txTime returns the transaction timestamp, which is the same on every endorsing peer
+func txTime(ctx contractapi.TransactionContextInterface) (time.Time, error) {
+	timestamp, err := ctx.GetStub().GetTxTimestamp()
+	if err != nil {
+		return time.Time{}, errorf(CodeInternal, "could not get the transaction timestamp. %s", err)
+	}
+	return timestamp.AsTime().UTC(), nil
+}
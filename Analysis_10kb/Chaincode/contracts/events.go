@@ -0,0 +1,156 @@
+package contracts
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+// Names of the chaincode events emitted by the contracts
+const (
+	EventCarCreated      = "CarCreated"
+	EventCarUpdated      = "CarUpdated"
+	EventCarDeleted      = "CarDeleted"
+	EventCarRegistered   = "CarRegistered"
+	EventCarArchived     = "CarArchived"
+	EventCarRestored     = "CarRestored"
+	EventCarPurged       = "CarPurged"
+	EventCarReplated     = "CarReplated"
+	EventCarDeregistered = "CarDeregistered"
+	EventOrderCreated    = "OrderCreated"
+	EventOrderMatched    = "OrderMatched"
+	EventOrdersMatched   = "OrdersMatched"
+	EventOrderCancelled  = "OrderCancelled"
+	EventOrderExpired    = "OrderExpired"
+	EventOrderFulfilled  = "OrderFulfilled"
+	EventOrderPurged     = "OrderPurged"
+	EventRecallIssued    = "RecallIssued"
+	EventRecallClosed    = "RecallClosed"
+)
+
+// CarEvent is the payload of the car events. OrderID is set when a new car was matched to an order
+// in the same transaction.
+type CarEvent struct {
+	Type      string `json:"type"`
+	CarId     string `json:"carId"`
+	OrderID   string `json:"orderID,omitempty"`
+	Car       *Car   `json:"car,omitempty"`
+	TxId      string `json:"txId"`
+	Timestamp string `json:"timestamp"`
+}
+
+// OrderEvent is the payload of the order events. Orders live in a private data collection,
+// so only their IDs are published.
+type OrderEvent struct {
+	Type      string `json:"type"`
+	OrderID   string `json:"orderID"`
+	CarId     string `json:"carId,omitempty"`
+	TxId      string `json:"txId"`
+	Timestamp string `json:"timestamp"`
+}
+
+// OrderMatch names a car and the order it was matched to
+type OrderMatch struct {
+	OrderID string `json:"orderID"`
+	CarId   string `json:"carId"`
+}
+
+// MatchingEvent is the payload of the OrdersMatched event, which lists every match of a RunMatching batch
+type MatchingEvent struct {
+	Type      string        `json:"type"`
+	Matches   []*OrderMatch `json:"matches"`
+	TxId      string        `json:"txId"`
+	Timestamp string        `json:"timestamp"`
+}
+
+// RecallEvent is the payload of the recall events; CarId is set when a single car is concerned
+type RecallEvent struct {
+	Type      string `json:"type"`
+	RecallID  string `json:"recallId"`
+	CarId     string `json:"carId,omitempty"`
+	TxId      string `json:"txId"`
+	Timestamp string `json:"timestamp"`
+}
+
+func setEvent(ctx contractapi.TransactionContextInterface, name string, event interface{}) error {
+	payload, _ := json.Marshal(event)
+	err := ctx.GetStub().SetEvent(name, payload)
+	if err != nil {
+		return errorf(CodeInternal, "could not set the %s event. %s", name, err)
+	}
+	return nil
+}
+
+// emitCarEvent publishes a car event; a transaction can only emit one event, so it is called once per transaction
+func emitCarEvent(ctx contractapi.TransactionContextInterface, name string, carID string, car *Car) error {
+	return emitMatchedCarEvent(ctx, name, carID, car, "")
+}
+
+// emitMatchedCarEvent publishes a car event naming the order the car was matched to
+func emitMatchedCarEvent(ctx contractapi.TransactionContextInterface, name string, carID string, car *Car, orderID string) error {
+	now, err := txTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	return setEvent(ctx, name, CarEvent{
+		Type:      name,
+		CarId:     carID,
+		OrderID:   orderID,
+		Car:       car,
+		TxId:      ctx.GetStub().GetTxID(),
+		Timestamp: now.Format(time.RFC3339),
+	})
+}
+
+// emitOrderEvent publishes an order event
+func emitOrderEvent(ctx contractapi.TransactionContextInterface, name string, orderID string, carID string) error {
+	now, err := txTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	return setEvent(ctx, name, OrderEvent{
+		Type:      name,
+		OrderID:   orderID,
+		CarId:     carID,
+		TxId:      ctx.GetStub().GetTxID(),
+		Timestamp: now.Format(time.RFC3339),
+	})
+}
+
+// emitMatchingEvent publishes the matches of a RunMatching batch as a single event
+func emitMatchingEvent(ctx contractapi.TransactionContextInterface, decisions []*MatchDecision) error {
+	now, err := txTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	matches := make([]*OrderMatch, 0, len(decisions))
+	for _, decision := range decisions {
+		matches = append(matches, &OrderMatch{OrderID: decision.OrderID, CarId: decision.CarId})
+	}
+	return setEvent(ctx, EventOrdersMatched, MatchingEvent{
+		Type:      EventOrdersMatched,
+		Matches:   matches,
+		TxId:      ctx.GetStub().GetTxID(),
+		Timestamp: now.Format(time.RFC3339),
+	})
+}
+
+// emitRecallEvent publishes a recall event
+func emitRecallEvent(ctx contractapi.TransactionContextInterface, name string, recallID string, carID string) error {
+	now, err := txTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	return setEvent(ctx, name, RecallEvent{
+		Type:      name,
+		RecallID:  recallID,
+		CarId:     carID,
+		TxId:      ctx.GetStub().GetTxID(),
+		Timestamp: now.Format(time.RFC3339),
+	})
+}
@@ -0,0 +1,165 @@
+package contracts
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+// defaultOrderRetentionDays is how long closed orders are kept when no retention has been configured
+const defaultOrderRetentionDays = 365
+
+// PurgeOrder removes an order, its order book entry and the match decisions of its cars from the
+// collection, including their history on the peers. Only the identity that placed the order can
+// purge it; orders placed before their creator was recorded need a dealer admin.
+func (o *OrderContract) PurgeOrder(ctx contractapi.TransactionContextInterface, orderID string) (string, error) {
+	err := checkAccess(ctx, "OrderContract:PurgeOrder")
+	if err != nil {
+		return "", err
+	}
+
+	order, err := readOrder(ctx, orderID)
+	if err != nil {
+		return "", err
+	}
+
+	err = requireOrderCreator(ctx, order, "purged")
+	if err != nil {
+		return "", err
+	}
+
+	// The order book entry is purged whatever the status, as a deleted entry keeps its history too
+	key, err := orderBookKey(ctx, order)
+	if err != nil {
+		return "", err
+	}
+	err = ctx.GetStub().PurgePrivateData(collectionName, key)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not purge the order book entry of order %s. %s", orderID, err)
+	}
+
+	for _, carID := range order.matchedCars() {
+		key, err := matchDecisionKey(ctx, carID, orderID)
+		if err != nil {
+			return "", err
+		}
+		err = ctx.GetStub().PurgePrivateData(collectionName, key)
+		if err != nil {
+			return "", errorf(CodeInternal, "could not purge the match decision of car %s and order %s. %s", carID, orderID, err)
+		}
+	}
+
+	err = ctx.GetStub().PurgePrivateData(collectionName, orderID)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not purge the order %s. %s", orderID, err)
+	}
+
+	err = emitOrderEvent(ctx, EventOrderPurged, orderID, "")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("order with id %v is purged", orderID), nil
+}
+
+// RetentionResult is one batch of GetOrdersPastRetention. Pass NextKey as the start key of the
+// next batch; it is empty once the whole collection has been scanned.
+type RetentionResult struct {
+	Orders  []*Order `json:"orders"`
+	Scanned int32    `json:"scanned"`
+	NextKey string   `json:"nextKey"`
+}
+
+// GetOrdersPastRetention scans up to limit orders from startKey on and returns the ones the client
+// placed that were closed longer than the retention period ago, for a retention job to purge in
+// batches. Orders placed before their creator was recorded are returned to dealer admins only, as
+// only they can purge them. The collection is read by key range rather than queried, so orders
+// stored in the protobuf encoding are found too. Open and matched orders are never returned.
+func (o *OrderContract) GetOrdersPastRetention(ctx contractapi.TransactionContextInterface, startKey string, limit int32) (*RetentionResult, error) {
+	err := checkAccess(ctx, "OrderContract:GetOrdersPastRetention")
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 || limit > maxCarPageSize {
+		return nil, errorf(CodeInvalidArgument, "the limit must be between 1 and %d", maxCarPageSize)
+	}
+
+	config, err := readConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clientIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	legacyAdmin, err := hasAccess(ctx, "OrderContract:ChangeLegacyOrder")
+	if err != nil {
+		return nil, err
+	}
+
+	now, err := txTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cutoff := now.AddDate(0, 0, -config.OrderRetentionDays).Format(time.RFC3339)
+
+	resultsIterator, err := ctx.GetStub().GetPrivateDataByRange(collectionName, startKey, "")
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not fetch the private data by range. %s", err)
+	}
+	defer resultsIterator.Close()
+
+	result := &RetentionResult{Orders: []*Order{}}
+	for resultsIterator.HasNext() {
+		queryResult, err := resultsIterator.Next()
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not fetch the details of the result iterator. %s", err)
+		}
+
+		if result.Scanned == limit {
+			result.NextKey = queryResult.Key
+			break
+		}
+		result.Scanned++
+
+		var order Order
+		err = unmarshalOrder(queryResult.Value, &order)
+		if err != nil || order.AssetType != "Order" {
+			continue
+		}
+		if !order.Status.closed() || order.UpdatedAt == "" || order.UpdatedAt >= cutoff {
+			continue
+		}
+		if order.CreatedBy == nil && !legacyAdmin || order.CreatedBy != nil && *order.CreatedBy != client {
+			continue
+		}
+		result.Orders = append(result.Orders, &order)
+	}
+	return result, nil
+}
+
+// SetOrderRetention sets how many days closed orders are kept before GetOrdersPastRetention lists them
+func (c *CarContract) SetOrderRetention(ctx contractapi.TransactionContextInterface, days int) (string, error) {
+	err := checkAccess(ctx, "CarContract:SetOrderRetention")
+	if err != nil {
+		return "", err
+	}
+
+	if days <= 0 {
+		return "", errorf(CodeInvalidArgument, "the retention must be at least one day")
+	}
+
+	config, err := readConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	config.OrderRetentionDays = days
+	err = writeConfig(ctx, config)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("order retention set to %v days", days), nil
+}
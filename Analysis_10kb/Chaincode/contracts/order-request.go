@@ -0,0 +1,49 @@
+package contracts
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+const (
+	maxOrderQuantity = 100
+	maxOrderOptions  = 16
+)
+
+// orderRequest is the JSON document CreateOrder accepts under the "order" transient key
+type orderRequest struct {
+	Make         string   `json:"make"`
+	Model        string   `json:"model"`
+	Color        string   `json:"color"`
+	DealerName   string   `json:"dealerName"`
+	Quantity     *int     `json:"quantity"`
+	Options      []string `json:"options"`
+	DeliveryDate string   `json:"deliveryDate"`
+	Notes        string   `json:"notes"`
+}
+
+// parseOrderRequest decodes an order request into the order. Unknown fields are rejected so a
+// misspelt field is not silently dropped, and an absent quantity means one car; the values are
+// checked by validateOrder.
+func parseOrderRequest(data []byte, order *Order) error {
+	var request orderRequest
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(&request)
+	if err != nil {
+		return errorf(CodeInvalidArgument, "could not parse the order. %s", err)
+	}
+
+	order.Make = request.Make
+	order.Model = request.Model
+	order.Color = request.Color
+	order.DealerName = request.DealerName
+	order.Quantity = 1
+	if request.Quantity != nil {
+		order.Quantity = *request.Quantity
+	}
+	order.Options = request.Options
+	order.DeliveryDate = request.DeliveryDate
+	order.Notes = request.Notes
+	return nil
+}
@@ -0,0 +1,324 @@
+package contracts
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"kbaauto/query"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+// OrderStatus is a stage in the order lifecycle
+type OrderStatus string
+
+const (
+	OrderOpen      OrderStatus = "Open"
+	OrderMatched   OrderStatus = "Matched"
+	OrderCancelled OrderStatus = "Cancelled"
+	OrderExpired   OrderStatus = "Expired"
+	OrderFulfilled OrderStatus = "Fulfilled"
+)
+
+// orderValidity is how long an order stays open for matching
+const orderValidity = 30 * 24 * time.Hour
+
+// orderTransitions lists the stages an order may move to from each stage
+var orderTransitions = map[OrderStatus][]OrderStatus{
+	OrderOpen:      {OrderMatched, OrderCancelled, OrderExpired},
+	OrderMatched:   {OrderFulfilled},
+	OrderCancelled: {},
+	OrderExpired:   {},
+	OrderFulfilled: {},
+}
+
+// CanTransitionTo returns true when the lifecycle allows moving from s to next
+func (s OrderStatus) CanTransitionTo(next OrderStatus) bool {
+	for _, allowed := range orderTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// closed returns true for the final stages of the lifecycle, which orders never leave
+func (s OrderStatus) closed() bool {
+	transitions, ok := orderTransitions[s]
+	return ok && len(transitions) == 0
+}
+
+// expired returns true when an open order can no longer be matched at the given time
+func (o *Order) expired(now time.Time) bool {
+	if o.ExpiresAt == "" {
+		return false
+	}
+	expiresAt, err := time.Parse(time.RFC3339, o.ExpiresAt)
+	return err != nil || !now.Before(expiresAt)
+}
+
+// readOrder returns an order from the collection. Orders written before they had a status are open.
+func readOrder(ctx contractapi.TransactionContextInterface, orderID string) (*Order, error) {
+	bytes, err := ctx.GetStub().GetPrivateData(collectionName, orderID)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not get the private data. %s", err)
+	}
+	if bytes == nil {
+		return nil, errorf(CodeNotFound, "the asset %s does not exist", orderID)
+	}
+
+	var order Order
+	err = unmarshalOrder(bytes, &order)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not unmarshal private data collection data to type Order")
+	}
+	if order.Status == "" {
+		order.Status = OrderOpen
+	}
+	return &order, nil
+}
+
+// requireOrderCreator fails unless the client placed the order. Orders placed before their creator
+// was recorded can only be changed by a dealer admin.
+func requireOrderCreator(ctx contractapi.TransactionContextInterface, order *Order, action string) error {
+	if order.CreatedBy == nil {
+		return checkAccess(ctx, "OrderContract:ChangeLegacyOrder")
+	}
+
+	client, err := clientIdentity(ctx)
+	if err != nil {
+		return err
+	}
+	if *order.CreatedBy != client {
+		return errorf(CodeUnauthorized, "the order %s belongs to %s and cannot be %s by %s", order.OrderID, order.CreatedBy, action, client)
+	}
+	return nil
+}
+
+// transitionOrder moves the order to the next stage on behalf of the client and writes it back
+func transitionOrder(ctx contractapi.TransactionContextInterface, order *Order, next OrderStatus) error {
+	if !order.Status.CanTransitionTo(next) {
+		return errorf(CodeConflict, "the order %s cannot move from %s to %s", order.OrderID, order.Status, next)
+	}
+
+	if order.Status == OrderOpen {
+		err := unindexOrder(ctx, order)
+		if err != nil {
+			return err
+		}
+	}
+
+	order.Status = next
+	return writeOrder(ctx, order)
+}
+
+// writeOrder writes the order back on behalf of the client
+func writeOrder(ctx contractapi.TransactionContextInterface, order *Order) error {
+	actor, err := clientIdentity(ctx)
+	if err != nil {
+		return err
+	}
+
+	now, err := txTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	order.UpdatedBy = &actor
+	order.UpdatedAt = now.Format(time.RFC3339)
+
+	bytes, err := marshalOrder(ctx, order)
+	if err != nil {
+		return err
+	}
+	err = ctx.GetStub().PutPrivateData(collectionName, order.OrderID, bytes)
+	if err != nil {
+		return errorf(CodeInternal, "could not update the order %s. %s", order.OrderID, err)
+	}
+	return nil
+}
+
+// quantity returns the number of cars the order is for; orders placed before quantities were recorded are for one car
+func (o *Order) quantity() int {
+	if o.Quantity == 0 {
+		return 1
+	}
+	return o.Quantity
+}
+
+// matchedCars returns the cars matched to the order, including the single car of orders matched
+// before every car was recorded
+func (o *Order) matchedCars() []string {
+	if len(o.CarIds) == 0 && o.CarId != "" {
+		return []string{o.CarId}
+	}
+	return o.CarIds
+}
+
+// openOrders drops the orders that can no longer be matched at the given time
+func openOrders(orders []*Order, now time.Time) []*Order {
+	open := []*Order{}
+	for _, order := range orders {
+		if order.Status == OrderOpen && !order.expired(now) {
+			open = append(open, order)
+		}
+	}
+	return open
+}
+
+// CancelOrder lets the dealer who placed an open order withdraw it; it stays in the collection as Cancelled
+func (o *OrderContract) CancelOrder(ctx contractapi.TransactionContextInterface, orderID string) (string, error) {
+	err := checkAccess(ctx, "OrderContract:CancelOrder")
+	if err != nil {
+		return "", err
+	}
+
+	err = o.cancelOrder(ctx, orderID)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("order with id %v is cancelled", orderID), nil
+}
+
+func (o *OrderContract) cancelOrder(ctx contractapi.TransactionContextInterface, orderID string) error {
+	order, err := readOrder(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	err = requireOrderCreator(ctx, order, "cancelled")
+	if err != nil {
+		return err
+	}
+
+	err = transitionOrder(ctx, order, OrderCancelled)
+	if err != nil {
+		return err
+	}
+	return emitOrderEvent(ctx, EventOrderCancelled, orderID, "")
+}
+
+// ExpireOrder marks an open order past its expiry time as Expired
+func (o *OrderContract) ExpireOrder(ctx contractapi.TransactionContextInterface, orderID string) (string, error) {
+	err := checkAccess(ctx, "OrderContract:ExpireOrder")
+	if err != nil {
+		return "", err
+	}
+
+	order, err := readOrder(ctx, orderID)
+	if err != nil {
+		return "", err
+	}
+
+	now, err := txTime(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !order.expired(now) {
+		return "", errorf(CodeConflict, "the order %s is open until %s", orderID, order.ExpiresAt)
+	}
+
+	err = transitionOrder(ctx, order, OrderExpired)
+	if err != nil {
+		return "", err
+	}
+
+	err = emitOrderEvent(ctx, EventOrderExpired, orderID, "")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("order with id %v is expired", orderID), nil
+}
+
+// FulfillOrder lets the dealer who placed a matched order close it once its cars have been sold
+func (o *OrderContract) FulfillOrder(ctx contractapi.TransactionContextInterface, orderID string) (string, error) {
+	err := checkAccess(ctx, "OrderContract:FulfillOrder")
+	if err != nil {
+		return "", err
+	}
+
+	order, err := readOrder(ctx, orderID)
+	if err != nil {
+		return "", err
+	}
+
+	err = requireOrderCreator(ctx, order, "fulfilled")
+	if err != nil {
+		return "", err
+	}
+	if order.CarId == "" {
+		return "", errorf(CodeConflict, "the order %s is not matched to a car", orderID)
+	}
+
+	for _, carID := range order.matchedCars() {
+		car, err := (&CarContract{}).readCar(ctx, carID)
+		if err != nil {
+			return "", err
+		}
+		if car.Status != StatusSold && car.Status != StatusRegistered {
+			return "", errorf(CodeConflict, "the car %s of order %s is not sold yet", car.CarId, orderID)
+		}
+	}
+
+	err = transitionOrder(ctx, order, OrderFulfilled)
+	if err != nil {
+		return "", err
+	}
+
+	err = emitOrderEvent(ctx, EventOrderFulfilled, orderID, order.CarId)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("order with id %v is fulfilled by car %v", orderID, order.CarId), nil
+}
+
+// GetOrderHistory returns the orders placed by the client, newest first, optionally limited to one
+// status. Dealer admins see the orders of their whole organisation.
+func (o *OrderContract) GetOrderHistory(ctx contractapi.TransactionContextInterface, status string) ([]*Order, error) {
+	err := checkAccess(ctx, "OrderContract:GetOrderHistory")
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clientIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wholeOrganisation, err := hasAccess(ctx, "OrderContract:ViewOrganisationOrders")
+	if err != nil {
+		return nil, err
+	}
+
+	q := query.New().Eq("assetType", "Order").Eq("createdBy.mspId", client.MSPID)
+	if !wholeOrganisation {
+		q.Eq("createdBy.enrollmentId", client.EnrollmentID)
+	}
+	if status != "" {
+		if _, ok := orderTransitions[OrderStatus(status)]; !ok {
+			return nil, errorf(CodeInvalidArgument, "unknown order status %s", status)
+		}
+		q.Eq("status", status)
+	}
+	queryString, err := q.String()
+	if err != nil {
+		return nil, errorf(CodeInternal, "%s", err)
+	}
+
+	resultsIterator, err := ctx.GetStub().GetPrivateDataQueryResult(collectionName, queryString)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not fetch the query result. %s", err)
+	}
+	defer resultsIterator.Close()
+
+	orders, err := OrderResultIteratorFunction(resultsIterator)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(orders, func(i, j int) bool {
+		return orders[i].CreatedAt > orders[j].CreatedAt
+	})
+	return orders, nil
+}
@@ -0,0 +1,136 @@
+// Package query builds CouchDB Mango queries for rich queries against the world state and
+// private data collections. Values are always marshalled as JSON, so they cannot alter the
+// structure of the query.
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Direction is the sort order of a field
+type Direction string
+
+const (
+	Ascending  Direction = "asc"
+	Descending Direction = "desc"
+)
+
+// Query is a Mango query under construction
+type Query struct {
+	selector map[string]map[string]interface{}
+	sort     []map[string]Direction
+	fields   []string
+	err      error
+}
+
+// New returns an empty query, which matches every document
+func New() *Query {
+	return &Query{selector: map[string]map[string]interface{}{}}
+}
+
+func (q *Query) checkField(field string) bool {
+	if q.err != nil {
+		return false
+	}
+	if field == "" || strings.HasPrefix(field, "$") {
+		q.err = fmt.Errorf("invalid field name %q", field)
+		return false
+	}
+	return true
+}
+
+func (q *Query) condition(field string, operator string, value interface{}) *Query {
+	if !q.checkField(field) {
+		return q
+	}
+	if q.selector[field] == nil {
+		q.selector[field] = map[string]interface{}{}
+	}
+	q.selector[field][operator] = value
+	return q
+}
+
+// Eq matches documents where field equals value
+func (q *Query) Eq(field string, value interface{}) *Query {
+	return q.condition(field, "$eq", value)
+}
+
+// Gt matches documents where field is greater than value
+func (q *Query) Gt(field string, value interface{}) *Query {
+	return q.condition(field, "$gt", value)
+}
+
+// Gte matches documents where field is greater than or equal to value
+func (q *Query) Gte(field string, value interface{}) *Query {
+	return q.condition(field, "$gte", value)
+}
+
+// Lt matches documents where field is less than value
+func (q *Query) Lt(field string, value interface{}) *Query {
+	return q.condition(field, "$lt", value)
+}
+
+// Lte matches documents where field is less than or equal to value
+func (q *Query) Lte(field string, value interface{}) *Query {
+	return q.condition(field, "$lte", value)
+}
+
+// In matches documents where field equals any of the values
+func (q *Query) In(field string, values ...interface{}) *Query {
+	if values == nil {
+		values = []interface{}{}
+	}
+	return q.condition(field, "$in", values)
+}
+
+// Exists matches documents that have field when exists is true, and documents without it otherwise
+func (q *Query) Exists(field string, exists bool) *Query {
+	return q.condition(field, "$exists", exists)
+}
+
+// Sort orders the results by field; sorted fields must be covered by an index
+func (q *Query) Sort(field string, direction Direction) *Query {
+	if !q.checkField(field) {
+		return q
+	}
+	if direction != Ascending && direction != Descending {
+		q.err = fmt.Errorf("invalid sort direction %q for %s", direction, field)
+		return q
+	}
+	q.sort = append(q.sort, map[string]Direction{field: direction})
+	return q
+}
+
+// Fields limits the returned documents to the given fields
+func (q *Query) Fields(fields ...string) *Query {
+	for _, field := range fields {
+		if !q.checkField(field) {
+			return q
+		}
+	}
+	q.fields = append(q.fields, fields...)
+	return q
+}
+
+// String returns the query as a JSON document, or the first error found while building it
+func (q *Query) String() (string, error) {
+	if q.err != nil {
+		return "", q.err
+	}
+
+	document := map[string]interface{}{"selector": q.selector}
+	if len(q.sort) > 0 {
+		document["sort"] = q.sort
+	}
+	if len(q.fields) > 0 {
+		document["fields"] = q.fields
+	}
+
+	bytes, err := json.Marshal(document)
+	if err != nil {
+		return "", fmt.Errorf("could not marshal the query. %s", err)
+	}
+	return string(bytes), nil
+}
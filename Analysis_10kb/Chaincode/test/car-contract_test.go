@@ -55,9 +55,62 @@ func prepMocks(orgMSP string) (*mocks.TransactionContext, *mocks.ChaincodeStub)
 	return transactionContext, chaincodeStub
 }
 
+func prepWorldState(chaincodeStub *mocks.ChaincodeStub) map[string][]byte {
+	// back the chaincode stub with an in-memory world state
+	worldState := map[string][]byte{}
+
+	chaincodeStub.GetStateCalls(func(key string) ([]byte, error) {
+		return worldState[key], nil
+	})
+	chaincodeStub.PutStateCalls(func(key string, value []byte) error {
+		worldState[key] = value
+		return nil
+	})
+	chaincodeStub.DelStateCalls(func(key string) error {
+		delete(worldState, key)
+		return nil
+	})
+	validationParameters := map[string][]byte{}
+	chaincodeStub.SetStateValidationParameterCalls(func(key string, policy []byte) error {
+		validationParameters[key] = policy
+		return nil
+	})
+	chaincodeStub.GetStateValidationParameterCalls(func(key string) ([]byte, error) {
+		return validationParameters[key], nil
+	})
+	chaincodeStub.CreateCompositeKeyCalls(func(objectType string, attributes []string) (string, error) {
+		key := "\x00" + objectType + "\x00"
+		for _, attribute := range attributes {
+			key += attribute + "\x00"
+		}
+		return key, nil
+	})
+
+	return worldState
+}
+
+func setClient(transactionContext *mocks.TransactionContext, orgMSP string, enrollmentID string) {
+	clientIdentity := transactionContext.GetClientIdentity().(*mocks.ClientIdentity)
+	clientIdentity.GetMSPIDReturns(orgMSP, nil)
+	clientIdentity.GetAttributeValueStub = nil
+	clientIdentity.GetAttributeValueReturns(enrollmentID, true, nil)
+}
+
+// setAdmin gives the client the role=admin attribute next to its enrollment ID
+func setAdmin(transactionContext *mocks.TransactionContext, enrollmentID string) {
+	clientIdentity := transactionContext.GetClientIdentity().(*mocks.ClientIdentity)
+	clientIdentity.GetAttributeValueStub = func(name string) (string, bool, error) {
+		if name == "role" {
+			return "admin", true, nil
+		}
+		return enrollmentID, true, nil
+	}
+}
+
 func TestCreateCar(t *testing.T) {
 	// Set up mocks
 	transactionContext, chaincodeStub := prepMocks(orgMsp)
+	setClient(transactionContext, orgMsp, "user1")
 
 	// Create CarContract instance
 	carAsset := contracts.CarContract{}
@@ -71,17 +124,17 @@ func TestCreateCar(t *testing.T) {
 
 	// Assert successful creation
 	require.NoError(t, err)
-	require.Equal(t, "successfully added car car1", result)
+	require.Equal(t, "successfully added car car1  Enrollment ID is user1", result)
 
 	// Assert car already exist
 	chaincodeStub.GetStateReturns([]byte{}, nil)
 	_, err = carAsset.CreateCar(transactionContext, "car1", "", "", "", "", "")
-	require.EqualError(t, err, "the car, car1 already exists")
+	requireError(t, err, contracts.CodeAlreadyExists, "the car, car1 already exists")
 
 	// Assert reading error
 	chaincodeStub.GetStateReturns(nil, fmt.Errorf("some error"))
 	_, err = carAsset.CreateCar(transactionContext, "car1", "", "", "", "", "")
-	require.EqualError(t, err, "failed to read from world state: some error")
+	requireError(t, err, contracts.CodeInternal, "failed to read from world state: some error")
 }
 
 func TestReadCar(t *testing.T) {
@@ -108,43 +161,81 @@ func TestReadCar(t *testing.T) {
 	// Assert reading error
 	chaincodeStub.GetStateReturns(nil, fmt.Errorf("unable to retrieve car"))
 	_, err = carAsset.ReadCar(transactionContext, "")
-	require.EqualError(t, err, "failed to read from world state: unable to retrieve car")
+	requireError(t, err, contracts.CodeInternal, "failed to read from world state: unable to retrieve car")
 
 	// Assert car doesn't exist
 	chaincodeStub.GetStateReturns(nil, nil)
 	car, err = carAsset.ReadCar(transactionContext, "car1")
-	require.EqualError(t, err, "the car car1 does not exist")
+	requireError(t, err, contracts.CodeNotFound, "the car car1 does not exist")
 	require.Nil(t, car)
 }
 
 func TestDeleteCar(t *testing.T) {
 	// Set up mocks
 	transactionContext, chaincodeStub := prepMocks(orgMsp)
+	setClient(transactionContext, orgMsp, "user1")
 
 	// Create CarContract instance
 	carAsset := contracts.CarContract{}
 
 	// Create pointer to Car struct
-	car := &contracts.Car{CarId: "car1"}
+	car := &contracts.Car{CarId: "car1", Status: contracts.StatusManufactured}
 	bytes, err := json.Marshal(car)
 	require.NoError(t, err)
 
 	// Configure mock behavior
 	chaincodeStub.GetStateReturns(bytes, nil)
-	chaincodeStub.DelStateReturns(nil)
+	chaincodeStub.PutStateReturns(nil)
 
-	// Assert successful removal of car
+	// Assert the car is archived instead of removed
 	result, err := carAsset.DeleteCar(transactionContext, "car1")
 	require.NoError(t, err)
-	require.Equal(t, "car with id car1 is deleted from the world state.", result)
+	require.Equal(t, "car with id car1 is deleted and archived.", result)
+	require.Equal(t, 0, chaincodeStub.DelStateCallCount())
 
 	// Assert car doesn't exist
 	chaincodeStub.GetStateReturns(nil, nil)
 	_, err = carAsset.DeleteCar(transactionContext, "car1")
-	require.EqualError(t, err, "the car, car1 does not exist")
+	requireError(t, err, contracts.CodeNotFound, "the car, car1 does not exist")
 
 	// Assert reading error
 	chaincodeStub.GetStateReturns(nil, fmt.Errorf("unable to retrieve car"))
 	_, err = carAsset.DeleteCar(transactionContext, "")
-	require.EqualError(t, err, "failed to read from world state: unable to retrieve car")
+	requireError(t, err, contracts.CodeInternal, "failed to read from world state: unable to retrieve car")
+}
+
+func TestCarEvents(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks(orgMsp)
+	prepWorldState(chaincodeStub)
+	setClient(transactionContext, orgMsp, "user1")
+	chaincodeStub.GetTxIDReturns("tx1")
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	// Assert creating a car emits CarCreated with the new car
+	_, err := carAsset.CreateCar(transactionContext, "car1", "Honda", "Civic", "Blue", "Factory-01", "2024-01-01")
+	require.NoError(t, err)
+
+	name, payload := chaincodeStub.SetEventArgsForCall(0)
+	require.Equal(t, contracts.EventCarCreated, name)
+
+	var event contracts.CarEvent
+	require.NoError(t, json.Unmarshal(payload, &event))
+	require.Equal(t, "car1", event.CarId)
+	require.Equal(t, "tx1", event.TxId)
+	require.Equal(t, "Civic", event.Car.Model)
+
+	// Assert deleting a car emits CarDeleted without a car
+	_, err = carAsset.DeleteCar(transactionContext, "car1")
+	require.NoError(t, err)
+
+	name, payload = chaincodeStub.SetEventArgsForCall(1)
+	require.Equal(t, contracts.EventCarDeleted, name)
+
+	event = contracts.CarEvent{}
+	require.NoError(t, json.Unmarshal(payload, &event))
+	require.Equal(t, "car1", event.CarId)
+	require.Nil(t, event.Car)
 }
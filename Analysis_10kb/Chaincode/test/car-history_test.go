@@ -0,0 +1,147 @@
+package chaincodetest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"kbaauto/contracts"
+
+	"github.com/hyperledger/fabric-protos-go/ledger/queryresult"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// historyIterator iterates over a fixed list of key modifications
+type historyIterator struct {
+	modifications []*queryresult.KeyModification
+}
+
+func (i *historyIterator) HasNext() bool { return len(i.modifications) > 0 }
+
+func (i *historyIterator) Close() error { return nil }
+
+func (i *historyIterator) Next() (*queryresult.KeyModification, error) {
+	modification := i.modifications[0]
+	i.modifications = i.modifications[1:]
+	return modification, nil
+}
+
+// carVersions returns the history of car1, newest first like the peer returns it
+func carVersions(t *testing.T) *historyIterator {
+	created, err := json.Marshal(&contracts.Car{CarId: "car1", Color: "Red", Status: contracts.StatusManufactured})
+	require.NoError(t, err)
+	painted, err := json.Marshal(&contracts.Car{CarId: "car1", Color: "Blue", Status: contracts.StatusManufactured})
+	require.NoError(t, err)
+
+	return &historyIterator{modifications: []*queryresult.KeyModification{
+		{TxId: "tx3", IsDelete: true, Timestamp: &timestamppb.Timestamp{Seconds: 1700000200}},
+		{TxId: "tx2", Value: painted, Timestamp: &timestamppb.Timestamp{Seconds: 1700000100}},
+		{TxId: "tx1", Value: created, Timestamp: &timestamppb.Timestamp{Seconds: 1700000000}},
+	}}
+}
+
+func TestGetCarHistory(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("DealerMSP")
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	// Assert the full history is ordered oldest first with field level changes
+	chaincodeStub.GetHistoryForKeyReturns(carVersions(t), nil)
+	records, err := carAsset.GetCarHistory(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	require.Equal(t, "tx1", records[0].TxId)
+	require.Equal(t, "2023-11-14T22:13:20Z", records[0].Timestamp)
+	require.Equal(t, int64(1700000000), records[0].Epoch)
+	require.Equal(t, []*contracts.FieldChange{{Field: "color", From: `"Red"`, To: `"Blue"`}}, records[1].Changes)
+	require.True(t, records[2].IsDelete)
+
+	// Assert versions committed in the same block keep their order
+	created, err := json.Marshal(&contracts.Car{CarId: "car1", Color: "Red"})
+	require.NoError(t, err)
+	painted, err := json.Marshal(&contracts.Car{CarId: "car1", Color: "Blue"})
+	require.NoError(t, err)
+	chaincodeStub.GetHistoryForKeyReturns(&historyIterator{modifications: []*queryresult.KeyModification{
+		{TxId: "tx2", Value: painted, Timestamp: &timestamppb.Timestamp{Seconds: 1700000000}},
+		{TxId: "tx1", Value: created, Timestamp: &timestamppb.Timestamp{Seconds: 1700000000}},
+	}}, nil)
+	records, err = carAsset.GetCarHistory(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Equal(t, "tx1", records[0].TxId)
+	require.Equal(t, "tx2", records[1].TxId)
+	require.Equal(t, []*contracts.FieldChange{{Field: "color", From: `"Red"`, To: `"Blue"`}}, records[1].Changes)
+}
+
+func TestGetCarHistoryPage(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("DealerMSP")
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	// Assert a page is read newest first and stops one version past the page
+	versions := carVersions(t)
+	chaincodeStub.GetHistoryForKeyReturns(versions, nil)
+	page, err := carAsset.GetCarHistoryPage(transactionContext, "car1", "", "", 1, "")
+	require.NoError(t, err)
+	require.Len(t, page.Records, 1)
+	require.Equal(t, "tx3", page.Bookmark)
+	require.True(t, page.Records[0].IsDelete)
+	require.Nil(t, page.Records[0].Record)
+	require.Len(t, versions.modifications, 1)
+
+	// Assert the bookmark resumes after the last returned version, diffed against the older one
+	chaincodeStub.GetHistoryForKeyReturns(carVersions(t), nil)
+	page, err = carAsset.GetCarHistoryPage(transactionContext, "car1", "", "", 1, page.Bookmark)
+	require.NoError(t, err)
+	require.Len(t, page.Records, 1)
+	require.Equal(t, "tx2", page.Records[0].TxId)
+	require.Equal(t, []*contracts.FieldChange{{Field: "color", From: `"Red"`, To: `"Blue"`}}, page.Records[0].Changes)
+	require.Equal(t, "tx2", page.Bookmark)
+
+	chaincodeStub.GetHistoryForKeyReturns(carVersions(t), nil)
+	page, err = carAsset.GetCarHistoryPage(transactionContext, "car1", "", "", 1, page.Bookmark)
+	require.NoError(t, err)
+	require.Len(t, page.Records, 1)
+	require.Equal(t, "tx1", page.Records[0].TxId)
+	require.Empty(t, page.Bookmark)
+
+	chaincodeStub.GetHistoryForKeyReturns(carVersions(t), nil)
+	_, err = carAsset.GetCarHistoryPage(transactionContext, "car1", "", "", 1, "tx9")
+	requireError(t, err, contracts.CodeInvalidArgument, "invalid bookmark tx9")
+
+	// Assert the time filters are inclusive and accept both formats
+	chaincodeStub.GetHistoryForKeyReturns(carVersions(t), nil)
+	page, err = carAsset.GetCarHistoryPage(transactionContext, "car1", "1700000100", "2023-11-14T22:15:00Z", 10, "")
+	require.NoError(t, err)
+	require.Len(t, page.Records, 1)
+	require.Equal(t, "tx2", page.Records[0].TxId)
+	require.Empty(t, page.Bookmark)
+
+	_, err = carAsset.GetCarHistoryPage(transactionContext, "car1", "yesterday", "", 10, "")
+	requireError(t, err, contracts.CodeInvalidArgument, `invalid time "yesterday", expected RFC3339 or seconds since the epoch`)
+}
+
+func TestGetCarAsOf(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("DealerMSP")
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	chaincodeStub.GetHistoryForKeyReturns(carVersions(t), nil)
+	car, err := carAsset.GetCarAsOf(transactionContext, "car1", "1700000150")
+	require.NoError(t, err)
+	require.Equal(t, "Blue", car.Color)
+
+	// Assert the car cannot be read before it was created or after it was deleted
+	chaincodeStub.GetHistoryForKeyReturns(carVersions(t), nil)
+	_, err = carAsset.GetCarAsOf(transactionContext, "car1", "1699999999")
+	requireError(t, err, contracts.CodeNotFound, "the car car1 did not exist at 2023-11-14T22:13:19Z")
+
+	chaincodeStub.GetHistoryForKeyReturns(carVersions(t), nil)
+	_, err = carAsset.GetCarAsOf(transactionContext, "car1", "1700000200")
+	requireError(t, err, contracts.CodeNotFound, "the car car1 did not exist at 2023-11-14T22:16:40Z")
+}
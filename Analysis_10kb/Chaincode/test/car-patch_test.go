@@ -0,0 +1,50 @@
+package chaincodetest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"kbaauto/contracts"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatchCar(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("ManufacturerMSP")
+	worldState := prepWorldState(chaincodeStub)
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	bytes, err := json.Marshal(&contracts.Car{CarId: "car1", Color: "Red", Make: "Honda", OwnedBy: "Dealer1", Status: contracts.StatusAtDealer, Version: 3})
+	require.NoError(t, err)
+	worldState["car1"] = bytes
+
+	// Assert only the patched fields change and the version is bumped
+	result, err := carAsset.PatchCar(transactionContext, "car1", `{"color":"Blue"}`, 3)
+	require.NoError(t, err)
+	require.Equal(t, "successfully patched car car1 to version 4", result)
+
+	var car contracts.Car
+	require.NoError(t, json.Unmarshal(worldState["car1"], &car))
+	require.Equal(t, "Blue", car.Color)
+	require.Equal(t, "Honda", car.Make)
+	require.Equal(t, "Dealer1", car.OwnedBy)
+	require.Equal(t, contracts.StatusAtDealer, car.Status)
+	require.Equal(t, int64(4), car.Version)
+
+	// Assert a stale version is rejected
+	_, err = carAsset.PatchCar(transactionContext, "car1", `{"color":"Green"}`, 3)
+	requireError(t, err, contracts.CodeConflict, "the car car1 is at version 4, expected 3")
+
+	// Assert protected and unknown fields are rejected
+	_, err = carAsset.PatchCar(transactionContext, "car1", `{"status":"Sold"}`, 0)
+	requireError(t, err, contracts.CodeInvalidArgument, "the field status cannot be patched")
+
+	_, err = carAsset.PatchCar(transactionContext, "car1", `{"engine":"V8"}`, 0)
+	requireError(t, err, contracts.CodeInvalidArgument, "unknown car field engine")
+
+	_, err = carAsset.PatchCar(transactionContext, "car1", `{"color":7}`, 0)
+	requireError(t, err, contracts.CodeInvalidArgument, "the field color must be a string")
+}
@@ -0,0 +1,45 @@
+package chaincodetest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"kbaauto/contracts"
+
+	"github.com/stretchr/testify/require"
+)
+
+// requireError asserts that err is a ContractError with the given code and message
+func requireError(t *testing.T, err error, code contracts.ErrorCode, message string) {
+	t.Helper()
+	var contractErr *contracts.ContractError
+	require.ErrorAs(t, err, &contractErr)
+	require.Equal(t, code, contractErr.Code)
+	require.Equal(t, message, contractErr.Message)
+}
+
+func TestContractErrors(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("MvdMSP")
+	prepWorldState(chaincodeStub)
+	setClient(transactionContext, "MvdMSP", "user1")
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	// Assert the error message is the JSON form of the code and message
+	_, err := carAsset.ReadCar(transactionContext, "car1")
+	require.JSONEq(t, `{"code":"NOT_FOUND","message":"the car car1 does not exist"}`, err.Error())
+
+	var decoded contracts.ContractError
+	require.NoError(t, json.Unmarshal([]byte(err.Error()), &decoded))
+	require.Equal(t, contracts.CodeNotFound, decoded.Code)
+
+	// Assert a wrapped error keeps the code of its cause
+	_, err = carAsset.ScrapCar(transactionContext, "car1")
+	requireError(t, err, contracts.CodeNotFound, "could not read the data. the car car1 does not exist")
+
+	// Assert access denials are reported as unauthorized
+	_, err = carAsset.CreateCar(transactionContext, "car1", "Honda", "Civic", "Blue", "Factory-01", "2023-07-22")
+	requireError(t, err, contracts.CodeUnauthorized, "user under following MSPID: MvdMSP can't perform this action")
+}
@@ -0,0 +1,183 @@
+package chaincodetest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"kbaauto/contracts"
+
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPurgeOrder(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("DealerMSP")
+	worldState := prepWorldState(chaincodeStub)
+	privateData := prepPrivateData(chaincodeStub)
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000}, nil)
+
+	// Create contract instances
+	carAsset := contracts.CarContract{}
+	orderAsset := contracts.OrderContract{}
+
+	createOrder(t, transactionContext, chaincodeStub, "order1", "KBA3")
+	require.Len(t, privateData, 2)
+
+	// Assert only the identity that placed the order can purge it
+	setClient(transactionContext, "Org2MSP", "dealer2")
+	_, err := orderAsset.PurgeOrder(transactionContext, "order1")
+	requireError(t, err, contracts.CodeUnauthorized, "the order order1 belongs to DealerMSP/dealer1 and cannot be purged by Org2MSP/dealer2")
+
+	setClient(transactionContext, "DealerMSP", "dealer2")
+	_, err = orderAsset.PurgeOrder(transactionContext, "order1")
+	requireError(t, err, contracts.CodeUnauthorized, "the order order1 belongs to DealerMSP/dealer1 and cannot be purged by DealerMSP/dealer2")
+
+	// Assert the order and its order book entry are purged, not deleted
+	setClient(transactionContext, "DealerMSP", "dealer1")
+	result, err := orderAsset.PurgeOrder(transactionContext, "order1")
+	require.NoError(t, err)
+	require.Equal(t, "order with id order1 is purged", result)
+	require.Empty(t, privateData)
+	require.Equal(t, 2, chaincodeStub.PurgePrivateDataCallCount())
+	require.Equal(t, 0, chaincodeStub.DelPrivateDataCallCount())
+
+	_, key := chaincodeStub.PurgePrivateDataArgsForCall(1)
+	require.Equal(t, "order1", key)
+
+	name, _ := chaincodeStub.SetEventArgsForCall(chaincodeStub.SetEventCallCount() - 1)
+	require.Equal(t, contracts.EventOrderPurged, name)
+
+	_, err = orderAsset.PurgeOrder(transactionContext, "order1")
+	requireError(t, err, contracts.CodeNotFound, "the asset order1 does not exist")
+
+	// Assert the match decision of a matched order is purged with it
+	bytes, err := json.Marshal(&contracts.Car{AssetType: "car", CarId: "car1", Make: "Tata", Model: "Nexon", Color: "White", Status: contracts.StatusManufactured})
+	require.NoError(t, err)
+	worldState["car1"] = bytes
+
+	createOrder(t, transactionContext, chaincodeStub, "order2", "KBA3")
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+	_, err = carAsset.MatchOrder(transactionContext, "car1", "order2")
+	require.NoError(t, err)
+	require.Contains(t, privateData, "\x00matchdecision\x00car1\x00order2\x00")
+
+	setClient(transactionContext, "DealerMSP", "dealer1")
+	_, err = orderAsset.PurgeOrder(transactionContext, "order2")
+	require.NoError(t, err)
+	require.Empty(t, privateData)
+
+	// Assert an order placed before its creator was recorded needs a dealer admin
+	privateData["order3"] = []byte(`{"assetType":"Order","color":"White","dealerName":"KBA3","make":"Tata","model":"Nexon","orderID":"order3"}`)
+	_, err = orderAsset.PurgeOrder(transactionContext, "order3")
+	requireError(t, err, contracts.CodeUnauthorized, "unauthorized user: must have 'role=admin'")
+
+	setAdmin(transactionContext, "dealer1")
+	_, err = orderAsset.PurgeOrder(transactionContext, "order3")
+	require.NoError(t, err)
+	require.Empty(t, privateData)
+}
+
+func TestOrdersPastRetention(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("DealerMSP")
+	prepWorldState(chaincodeStub)
+	privateData := prepPrivateData(chaincodeStub)
+	chaincodeStub.GetPrivateDataByRangeCalls(func(collection string, startKey string, endKey string) (shim.StateQueryIteratorInterface, error) {
+		return newStateIterator(privateData, startKey, endKey), nil
+	})
+
+	// Create contract instances
+	carAsset := contracts.CarContract{}
+	orderAsset := contracts.OrderContract{}
+
+	// order1 and order2 are closed a day apart, order3 stays open and order4 belongs to another dealer
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000}, nil)
+	for _, orderID := range []string{"order1", "order2", "order3"} {
+		createOrder(t, transactionContext, chaincodeStub, orderID, "KBA3")
+	}
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000 + 24*60*60}, nil)
+	_, err := orderAsset.CancelOrder(transactionContext, "order2")
+	require.NoError(t, err)
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000}, nil)
+	_, err = orderAsset.CancelOrder(transactionContext, "order1")
+	require.NoError(t, err)
+
+	createOrderAs(t, transactionContext, chaincodeStub, "dealer2", "order4", "KBA3")
+	_, err = orderAsset.CancelOrder(transactionContext, "order4")
+	require.NoError(t, err)
+
+	// order5 is closed and stored in the protobuf encoding, order6 was placed before creators were recorded
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+	setAdmin(transactionContext, "user1")
+	_, err = carAsset.SetStateEncoding(transactionContext, contracts.StateEncodingProtobuf)
+	require.NoError(t, err)
+	createOrder(t, transactionContext, chaincodeStub, "order5", "KBA3")
+	_, err = orderAsset.CancelOrder(transactionContext, "order5")
+	require.NoError(t, err)
+	require.NotEqual(t, byte('{'), privateData["order5"][0])
+
+	privateData["order6"] = []byte(`{"assetType":"Order","color":"White","dealerName":"KBA3","make":"Tata","model":"Nexon","orderID":"order6","status":"Cancelled","updatedAt":"2023-11-14T22:13:20Z"}`)
+
+	// Assert nothing is listed within the default retention of a year
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000 + 100*24*60*60}, nil)
+	result, err := orderAsset.GetOrdersPastRetention(transactionContext, "", 10)
+	require.NoError(t, err)
+	require.Empty(t, result.Orders)
+
+	// Assert closed orders of the client past a shorter retention are listed, in batches
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+	setAdmin(transactionContext, "user1")
+	_, err = carAsset.SetOrderRetention(transactionContext, 90)
+	require.NoError(t, err)
+
+	_, err = carAsset.SetOrderRetention(transactionContext, 0)
+	requireError(t, err, contracts.CodeInvalidArgument, "the retention must be at least one day")
+
+	setClient(transactionContext, "DealerMSP", "dealer1")
+	result, err = orderAsset.GetOrdersPastRetention(transactionContext, "", 1)
+	require.NoError(t, err)
+	require.Len(t, result.Orders, 1)
+	require.Equal(t, "order1", result.Orders[0].OrderID)
+	require.Equal(t, "order2", result.NextKey)
+
+	result, err = orderAsset.GetOrdersPastRetention(transactionContext, result.NextKey, 2)
+	require.NoError(t, err)
+	require.Len(t, result.Orders, 1)
+	require.Equal(t, "order2", result.Orders[0].OrderID)
+	require.Equal(t, int32(2), result.Scanned)
+	require.Equal(t, "order4", result.NextKey)
+
+	result, err = orderAsset.GetOrdersPastRetention(transactionContext, result.NextKey, 10)
+	require.NoError(t, err)
+	require.Len(t, result.Orders, 1)
+	require.Equal(t, "order5", result.Orders[0].OrderID)
+	require.Empty(t, result.NextKey)
+
+	_, err = orderAsset.PurgeOrder(transactionContext, "order1")
+	require.NoError(t, err)
+
+	result, err = orderAsset.GetOrdersPastRetention(transactionContext, "", 10)
+	require.NoError(t, err)
+	require.Len(t, result.Orders, 2)
+	require.Equal(t, "order2", result.Orders[0].OrderID)
+	require.Equal(t, "order5", result.Orders[1].OrderID)
+
+	// Assert other dealers do not see the orders, and dealer admins see the legacy ones
+	setClient(transactionContext, "DealerMSP", "dealer2")
+	result, err = orderAsset.GetOrdersPastRetention(transactionContext, "", 10)
+	require.NoError(t, err)
+	require.Len(t, result.Orders, 1)
+	require.Equal(t, "order4", result.Orders[0].OrderID)
+
+	setAdmin(transactionContext, "dealer2")
+	result, err = orderAsset.GetOrdersPastRetention(transactionContext, "", 10)
+	require.NoError(t, err)
+	require.Len(t, result.Orders, 2)
+	require.Equal(t, "order4", result.Orders[0].OrderID)
+	require.Equal(t, "order6", result.Orders[1].OrderID)
+
+	_, err = orderAsset.GetOrdersPastRetention(transactionContext, "", 0)
+	requireError(t, err, contracts.CodeInvalidArgument, "the limit must be between 1 and 200")
+}
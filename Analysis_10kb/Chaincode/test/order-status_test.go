@@ -0,0 +1,297 @@
+package chaincodetest
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"sort"
+	"strings"
+	"testing"
+
+	"kbaauto/contracts"
+	"kbaauto/test/mocks"
+
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// prepPrivateData backs the order collection of the chaincode stub with an in-memory map.
+// Rich queries are not evaluated, they return every order. It expects the composite keys of prepWorldState.
+func prepPrivateData(chaincodeStub *mocks.ChaincodeStub) map[string][]byte {
+	privateData := map[string][]byte{}
+
+	chaincodeStub.PutPrivateDataCalls(func(collection string, key string, value []byte) error {
+		privateData[key] = value
+		return nil
+	})
+	chaincodeStub.GetPrivateDataCalls(func(collection string, key string) ([]byte, error) {
+		return privateData[key], nil
+	})
+	chaincodeStub.DelPrivateDataCalls(func(collection string, key string) error {
+		delete(privateData, key)
+		return nil
+	})
+	chaincodeStub.PurgePrivateDataCalls(func(collection string, key string) error {
+		delete(privateData, key)
+		return nil
+	})
+	chaincodeStub.GetPrivateDataHashCalls(func(collection string, key string) ([]byte, error) {
+		value, ok := privateData[key]
+		if !ok {
+			return nil, nil
+		}
+		hash := sha256.Sum256(value)
+		return hash[:], nil
+	})
+	chaincodeStub.GetPrivateDataQueryResultCalls(func(collection string, query string) (shim.StateQueryIteratorInterface, error) {
+		return newStateIterator(privateData, "", ""), nil
+	})
+	chaincodeStub.GetPrivateDataByPartialCompositeKeyCalls(func(collection string, objectType string, attributes []string) (shim.StateQueryIteratorInterface, error) {
+		prefix, _ := chaincodeStub.CreateCompositeKey(objectType, attributes)
+		iterator := &stateIterator{state: privateData}
+		for key := range privateData {
+			if strings.HasPrefix(key, prefix) {
+				iterator.keys = append(iterator.keys, key)
+			}
+		}
+		sort.Strings(iterator.keys)
+		return iterator, nil
+	})
+	chaincodeStub.SplitCompositeKeyCalls(func(key string) (string, []string, error) {
+		parts := strings.Split(strings.Trim(key, "\x00"), "\x00")
+		return parts[0], parts[1:], nil
+	})
+
+	return privateData
+}
+
+// createOrder places an order for a white Tata Nexon as dealer1
+func createOrder(t *testing.T, transactionContext *mocks.TransactionContext, chaincodeStub *mocks.ChaincodeStub, orderID string, dealerName string) {
+	createOrderAs(t, transactionContext, chaincodeStub, "dealer1", orderID, dealerName)
+}
+
+// createOrderAs places an order for a white Tata Nexon as the given DealerMSP identity
+func createOrderAs(t *testing.T, transactionContext *mocks.TransactionContext, chaincodeStub *mocks.ChaincodeStub, enrollmentID string, orderID string, dealerName string) {
+	setClient(transactionContext, "DealerMSP", enrollmentID)
+	chaincodeStub.GetTransientReturns(map[string][]byte{
+		"make":       []byte("Tata"),
+		"model":      []byte("Nexon"),
+		"color":      []byte("White"),
+		"dealerName": []byte(dealerName),
+	}, nil)
+	_, err := (&contracts.OrderContract{}).CreateOrder(transactionContext, orderID)
+	require.NoError(t, err)
+}
+
+func TestOrderLifecycle(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("ManufacturerMSP")
+	worldState := prepWorldState(chaincodeStub)
+	prepPrivateData(chaincodeStub)
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000}, nil)
+
+	// Create contract instances
+	carAsset := contracts.CarContract{}
+	orderAsset := contracts.OrderContract{}
+
+	bytes, err := json.Marshal(&contracts.Car{AssetType: "car", CarId: "car1", Make: "Tata", Model: "Nexon", Color: "White", Status: contracts.StatusManufactured})
+	require.NoError(t, err)
+	worldState["car1"] = bytes
+
+	// Assert a new order is open for 30 days
+	createOrder(t, transactionContext, chaincodeStub, "order1", "KBA3")
+	order, err := orderAsset.ReadOrder(transactionContext, "order1")
+	require.NoError(t, err)
+	require.Equal(t, contracts.OrderOpen, order.Status)
+	require.Equal(t, &contracts.Identity{MSPID: "DealerMSP", EnrollmentID: "dealer1"}, order.CreatedBy)
+	require.Equal(t, "2023-11-14T22:13:20Z", order.CreatedAt)
+	require.Equal(t, "2023-12-14T22:13:20Z", order.ExpiresAt)
+
+	_, err = orderAsset.FulfillOrder(transactionContext, "order1")
+	requireError(t, err, contracts.CodeConflict, "the order order1 is not matched to a car")
+
+	// Assert matching keeps the order and links it to the car
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+	result, err := carAsset.MatchOrder(transactionContext, "car1", "order1")
+	require.NoError(t, err)
+	require.Equal(t, "Matched order order1 and Assigned car1 to KBA3", result)
+
+	order, err = orderAsset.ReadOrder(transactionContext, "order1")
+	require.NoError(t, err)
+	require.Equal(t, contracts.OrderMatched, order.Status)
+	require.Equal(t, "car1", order.CarId)
+	require.Equal(t, "user1", order.UpdatedBy.EnrollmentID)
+
+	setClient(transactionContext, "DealerMSP", "dealer1")
+	_, err = orderAsset.CancelOrder(transactionContext, "order1")
+	requireError(t, err, contracts.CodeConflict, "the order order1 cannot move from Matched to Cancelled")
+
+	// Assert the order is fulfilled once its car is sold
+	_, err = orderAsset.FulfillOrder(transactionContext, "order1")
+	requireError(t, err, contracts.CodeConflict, "the car car1 of order order1 is not sold yet")
+
+	car, err := carAsset.ReadCar(transactionContext, "car1")
+	require.NoError(t, err)
+	car.Status = contracts.StatusSold
+	bytes, err = json.Marshal(car)
+	require.NoError(t, err)
+	worldState["car1"] = bytes
+
+	result, err = orderAsset.FulfillOrder(transactionContext, "order1")
+	require.NoError(t, err)
+	require.Equal(t, "order with id order1 is fulfilled by car car1", result)
+
+	name, _ := chaincodeStub.SetEventArgsForCall(chaincodeStub.SetEventCallCount() - 1)
+	require.Equal(t, contracts.EventOrderFulfilled, name)
+
+	// Assert a cancelled order stays in the collection
+	createOrder(t, transactionContext, chaincodeStub, "order2", "KBA3")
+	err = orderAsset.DeleteOrder(transactionContext, "order2")
+	require.NoError(t, err)
+
+	order, err = orderAsset.ReadOrder(transactionContext, "order2")
+	require.NoError(t, err)
+	require.Equal(t, contracts.OrderCancelled, order.Status)
+
+	_, err = orderAsset.ReadOrder(transactionContext, "order3")
+	requireError(t, err, contracts.CodeNotFound, "the asset order3 does not exist")
+}
+
+func TestOrderCreator(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("DealerMSP")
+	prepWorldState(chaincodeStub)
+	privateData := prepPrivateData(chaincodeStub)
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000}, nil)
+
+	// Create OrderContract instance
+	orderAsset := contracts.OrderContract{}
+
+	createOrder(t, transactionContext, chaincodeStub, "order1", "KBA3")
+
+	// Assert another dealer cannot cancel, delete or fulfill the order
+	setClient(transactionContext, "DealerMSP", "dealer2")
+	_, err := orderAsset.CancelOrder(transactionContext, "order1")
+	requireError(t, err, contracts.CodeUnauthorized, "the order order1 belongs to DealerMSP/dealer1 and cannot be cancelled by DealerMSP/dealer2")
+
+	err = orderAsset.DeleteOrder(transactionContext, "order1")
+	requireError(t, err, contracts.CodeUnauthorized, "the order order1 belongs to DealerMSP/dealer1 and cannot be cancelled by DealerMSP/dealer2")
+
+	_, err = orderAsset.FulfillOrder(transactionContext, "order1")
+	requireError(t, err, contracts.CodeUnauthorized, "the order order1 belongs to DealerMSP/dealer1 and cannot be fulfilled by DealerMSP/dealer2")
+
+	// Assert the dealer who placed the order can cancel it
+	setClient(transactionContext, "DealerMSP", "dealer1")
+	_, err = orderAsset.CancelOrder(transactionContext, "order1")
+	require.NoError(t, err)
+
+	// Assert an order placed before its creator was recorded needs a dealer admin
+	privateData["order2"] = []byte(`{"assetType":"order","color":"White","dealerName":"KBA3","make":"Tata","model":"Nexon","orderID":"order2"}`)
+	_, err = orderAsset.CancelOrder(transactionContext, "order2")
+	requireError(t, err, contracts.CodeUnauthorized, "unauthorized user: must have 'role=admin'")
+
+	setAdmin(transactionContext, "dealer1")
+	_, err = orderAsset.CancelOrder(transactionContext, "order2")
+	require.NoError(t, err)
+}
+
+func TestExpireOrder(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("ManufacturerMSP")
+	worldState := prepWorldState(chaincodeStub)
+	prepPrivateData(chaincodeStub)
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000}, nil)
+
+	// Create contract instances
+	carAsset := contracts.CarContract{}
+	orderAsset := contracts.OrderContract{}
+
+	bytes, err := json.Marshal(&contracts.Car{AssetType: "car", CarId: "car1", Make: "Tata", Model: "Nexon", Color: "White", Status: contracts.StatusManufactured})
+	require.NoError(t, err)
+	worldState["car1"] = bytes
+
+	createOrder(t, transactionContext, chaincodeStub, "order1", "KBA3")
+
+	_, err = orderAsset.ExpireOrder(transactionContext, "order1")
+	requireError(t, err, contracts.CodeConflict, "the order order1 is open until 2023-12-14T22:13:20Z")
+
+	// Assert an order past its expiry can no longer be matched
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000 + 30*24*60*60}, nil)
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+	_, err = carAsset.MatchOrder(transactionContext, "car1", "order1")
+	requireError(t, err, contracts.CodeConflict, "the order order1 expired at 2023-12-14T22:13:20Z")
+
+	result, err := orderAsset.ExpireOrder(transactionContext, "order1")
+	require.NoError(t, err)
+	require.Equal(t, "order with id order1 is expired", result)
+
+	order, err := orderAsset.ReadOrder(transactionContext, "order1")
+	require.NoError(t, err)
+	require.Equal(t, contracts.OrderExpired, order.Status)
+
+	_, err = orderAsset.ExpireOrder(transactionContext, "order1")
+	requireError(t, err, contracts.CodeConflict, "the order order1 cannot move from Expired to Expired")
+}
+
+func TestOrderQueries(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("DealerMSP")
+	worldState := prepWorldState(chaincodeStub)
+	privateData := prepPrivateData(chaincodeStub)
+
+	// Create contract instances
+	carAsset := contracts.CarContract{}
+	orderAsset := contracts.OrderContract{}
+
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000}, nil)
+	createOrder(t, transactionContext, chaincodeStub, "order1", "KBA3")
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000060}, nil)
+	createOrder(t, transactionContext, chaincodeStub, "order2", "KBA3")
+	_, err := orderAsset.CancelOrder(transactionContext, "order2")
+	require.NoError(t, err)
+
+	// An order written before orders had a status is open
+	bytes, err := json.Marshal(&contracts.Order{AssetType: "Order", OrderID: "order0", Make: "Tata", Model: "Nexon", Color: "White", DealerName: "KBA3"})
+	require.NoError(t, err)
+	privateData["order0"] = bytes
+
+	// Assert the history is limited to the client and sorted newest first
+	orders, err := orderAsset.GetOrderHistory(transactionContext, "")
+	require.NoError(t, err)
+	require.Len(t, orders, 3)
+	require.Equal(t, "order2", orders[0].OrderID)
+	require.Equal(t, "order1", orders[1].OrderID)
+	require.Equal(t, contracts.OrderOpen, orders[2].Status)
+
+	_, query := chaincodeStub.GetPrivateDataQueryResultArgsForCall(0)
+	require.JSONEq(t, `{"selector":{"assetType":{"$eq":"Order"},"createdBy.mspId":{"$eq":"DealerMSP"},"createdBy.enrollmentId":{"$eq":"dealer1"}}}`, query)
+
+	// Assert a dealer admin sees the history of the whole organisation
+	setAdmin(transactionContext, "dealer2")
+	_, err = orderAsset.GetOrderHistory(transactionContext, "")
+	require.NoError(t, err)
+
+	_, query = chaincodeStub.GetPrivateDataQueryResultArgsForCall(1)
+	require.JSONEq(t, `{"selector":{"assetType":{"$eq":"Order"},"createdBy.mspId":{"$eq":"DealerMSP"}}}`, query)
+	setClient(transactionContext, "DealerMSP", "dealer1")
+
+	_, err = orderAsset.GetOrderHistory(transactionContext, "Closed")
+	requireError(t, err, contracts.CodeInvalidArgument, "unknown order status Closed")
+
+	// Assert only open orders that have not expired are offered for matching
+	bytes, err = json.Marshal(&contracts.Car{AssetType: "car", CarId: "car1", Make: "Tata", Model: "Nexon", Color: "White", Status: contracts.StatusManufactured})
+	require.NoError(t, err)
+	worldState["car1"] = bytes
+
+	orders, err = carAsset.GetMatchingOrders(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Len(t, orders, 2)
+	require.Equal(t, "order0", orders[0].OrderID)
+	require.Equal(t, "order1", orders[1].OrderID)
+
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000 + 30*24*60*60}, nil)
+	orders, err = carAsset.GetMatchingOrders(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	require.Equal(t, "order0", orders[0].OrderID)
+}
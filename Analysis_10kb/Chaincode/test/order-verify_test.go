@@ -0,0 +1,107 @@
+package chaincodetest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"kbaauto/contracts"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestVerifyOrder(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("DealerMSP")
+	prepWorldState(chaincodeStub)
+	privateData := prepPrivateData(chaincodeStub)
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000}, nil)
+
+	// Create OrderContract instance
+	orderAsset := contracts.OrderContract{}
+
+	createOrder(t, transactionContext, chaincodeStub, "order1", "KBA3")
+	order, err := orderAsset.ReadOrder(transactionContext, "order1")
+	require.NoError(t, err)
+
+	// Assert the MVD can verify the order the dealer shows it
+	setClient(transactionContext, "MvdMSP", "mvd1")
+	claimed, err := json.Marshal(order)
+	require.NoError(t, err)
+	chaincodeStub.GetTransientReturns(map[string][]byte{"order": claimed}, nil)
+
+	verification, err := orderAsset.VerifyOrder(transactionContext, "order1")
+	require.NoError(t, err)
+	require.True(t, verification.Verified)
+	require.Equal(t, contracts.StateEncodingJSON, verification.Encoding)
+	require.Len(t, verification.LedgerHash, 64)
+
+	// Assert altered details do not verify
+	order.DealerName = "KBA4"
+	claimed, err = json.Marshal(order)
+	require.NoError(t, err)
+	chaincodeStub.GetTransientReturns(map[string][]byte{"order": claimed}, nil)
+
+	verification, err = orderAsset.VerifyOrder(transactionContext, "order1")
+	require.NoError(t, err)
+	require.False(t, verification.Verified)
+	require.Empty(t, verification.Encoding)
+
+	// Assert protobuf orders verify too
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+	setAdmin(transactionContext, "user1")
+	_, err = (&contracts.CarContract{}).SetStateEncoding(transactionContext, contracts.StateEncodingProtobuf)
+	require.NoError(t, err)
+	createOrder(t, transactionContext, chaincodeStub, "order2", "KBA3")
+	order, err = orderAsset.ReadOrder(transactionContext, "order2")
+	require.NoError(t, err)
+
+	claimed, err = json.Marshal(order)
+	require.NoError(t, err)
+	chaincodeStub.GetTransientReturns(map[string][]byte{"order": claimed}, nil)
+
+	verification, err = orderAsset.VerifyOrder(transactionContext, "order2")
+	require.NoError(t, err)
+	require.True(t, verification.Verified)
+	require.Equal(t, contracts.StateEncodingProtobuf, verification.Encoding)
+
+	_, err = orderAsset.VerifyOrder(transactionContext, "order1")
+	requireError(t, err, contracts.CodeInvalidArgument, "the order in transient data has the ID order2, expected order1")
+
+	chaincodeStub.GetTransientReturns(map[string][]byte{"order": []byte(`{"orderID":"order3","price":1}`)}, nil)
+	_, err = orderAsset.VerifyOrder(transactionContext, "order3")
+	requireError(t, err, contracts.CodeInvalidArgument, `could not parse the order. json: unknown field "price"`)
+
+	chaincodeStub.GetTransientReturns(map[string][]byte{"order": []byte(`{"orderID":"order3"}`)}, nil)
+	_, err = orderAsset.VerifyOrder(transactionContext, "order3")
+	requireError(t, err, contracts.CodeNotFound, "the asset order3 does not exist")
+
+	chaincodeStub.GetTransientReturns(map[string][]byte{}, nil)
+	_, err = orderAsset.VerifyOrder(transactionContext, "order3")
+	requireError(t, err, contracts.CodeInvalidArgument, "the order was not specified in transient data. Please try again")
+
+	// Assert an order placed before orders had a status verifies as ReadOrder returns it
+	privateData["order4"] = []byte(`{"assetType":"Order","color":"White","dealerName":"KBA3","make":"Tata","model":"Nexon","orderID":"order4"}`)
+	order, err = orderAsset.ReadOrder(transactionContext, "order4")
+	require.NoError(t, err)
+	require.Equal(t, contracts.OrderOpen, order.Status)
+
+	claimed, err = json.Marshal(order)
+	require.NoError(t, err)
+	chaincodeStub.GetTransientReturns(map[string][]byte{"order": claimed}, nil)
+
+	verification, err = orderAsset.VerifyOrder(transactionContext, "order4")
+	require.NoError(t, err)
+	require.True(t, verification.Verified)
+	require.Equal(t, contracts.StateEncodingJSON, verification.Encoding)
+
+	// Assert details the legacy form does not hold do not verify
+	order.Quantity = 2
+	claimed, err = json.Marshal(order)
+	require.NoError(t, err)
+	chaincodeStub.GetTransientReturns(map[string][]byte{"order": claimed}, nil)
+
+	verification, err = orderAsset.VerifyOrder(transactionContext, "order4")
+	require.NoError(t, err)
+	require.False(t, verification.Verified)
+}
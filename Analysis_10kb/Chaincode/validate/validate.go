@@ -0,0 +1,199 @@
+// Package validate checks struct fields against the rules declared in their validate tags, for example
+//
+//	Make string `json:"make" validate:"required,max=64,charset=name"`
+//
+// Fields are reported by their JSON names. The rules are:
+//
+//	required     the field must not be empty
+//	len=N        the field must have exactly N characters
+//	max=N        the field must have at most N characters
+//	charset=SET  every character must belong to SET: id, name, plate or hex
+//	date         the field must be a YYYY-MM-DD date
+//	mediatype    the field must be a media type such as application/pdf
+//	oneof=A|B    the field must be one of the listed values
+//
+// Rules other than required are skipped for empty fields. The rules of a string slice field apply
+// to each of its elements, which are reported as field[i].
+package validate
+
+import (
+	"fmt"
+	"mime"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// DateLayout is the layout of the dates accepted by the date rule
+const DateLayout = "2006-01-02"
+
+// charsets maps the charset names to the characters they allow
+var charsets = map[string]func(r rune) bool{
+	"id": func(r rune) bool {
+		return r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("-_.", r))
+	},
+	"name": func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune(" -_'.,&/()@", r)
+	},
+	"plate": func(r rune) bool {
+		return r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune(" -", r))
+	},
+	"hex": func(r rune) bool {
+		return strings.ContainsRune("0123456789abcdef", r)
+	},
+}
+
+// FieldError is a rule broken by a field
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s %s", e.Field, e.Message)
+}
+
+// Errors lists every rule broken by a struct
+type Errors []FieldError
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, fieldError := range e {
+		messages[i] = fieldError.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+func jsonName(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// Struct validates the string and string slice fields of the struct v points to. When fields are given, only the fields
+// with those JSON names are validated. A broken rule is reported as Errors, a malformed tag as an error.
+func Struct(v interface{}, fields ...string) error {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	if value.Kind() != reflect.Struct {
+		return fmt.Errorf("cannot validate %T, it is not a struct", v)
+	}
+
+	selected := map[string]bool{}
+	for _, field := range fields {
+		selected[field] = true
+	}
+
+	var errs Errors
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		tag, ok := field.Tag.Lookup("validate")
+		if !ok {
+			continue
+		}
+
+		name := jsonName(field)
+		if len(selected) > 0 && !selected[name] {
+			continue
+		}
+
+		switch {
+		case field.Type.Kind() == reflect.String:
+			message, err := check(value.Field(i).String(), tag)
+			if err != nil {
+				return fmt.Errorf("invalid validate tag on %s. %s", field.Name, err)
+			}
+			if message != "" {
+				errs = append(errs, FieldError{Field: name, Message: message})
+			}
+		case field.Type.Kind() == reflect.Slice && field.Type.Elem().Kind() == reflect.String:
+			for j := 0; j < value.Field(i).Len(); j++ {
+				message, err := check(value.Field(i).Index(j).String(), tag)
+				if err != nil {
+					return fmt.Errorf("invalid validate tag on %s. %s", field.Name, err)
+				}
+				if message != "" {
+					errs = append(errs, FieldError{Field: fmt.Sprintf("%s[%d]", name, j), Message: message})
+				}
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// check applies the rules of a tag to a value and describes the first rule it breaks
+func check(value string, tag string) (string, error) {
+	for _, rule := range strings.Split(tag, ",") {
+		name, arg, _ := strings.Cut(rule, "=")
+
+		if name == "required" {
+			if value == "" {
+				return "is required", nil
+			}
+			continue
+		}
+		if value == "" {
+			continue
+		}
+
+		switch name {
+		case "len":
+			length, err := strconv.Atoi(arg)
+			if err != nil {
+				return "", fmt.Errorf("invalid len %q", arg)
+			}
+			if len([]rune(value)) != length {
+				return fmt.Sprintf("must have exactly %d characters", length), nil
+			}
+		case "max":
+			limit, err := strconv.Atoi(arg)
+			if err != nil {
+				return "", fmt.Errorf("invalid max %q", arg)
+			}
+			if len([]rune(value)) > limit {
+				return fmt.Sprintf("must have at most %d characters", limit), nil
+			}
+		case "charset":
+			allowed, ok := charsets[arg]
+			if !ok {
+				return "", fmt.Errorf("unknown charset %q", arg)
+			}
+			for _, r := range value {
+				if !allowed(r) {
+					return fmt.Sprintf("contains the invalid character %q", r), nil
+				}
+			}
+		case "date":
+			_, err := time.Parse(DateLayout, value)
+			if err != nil {
+				return "must be a YYYY-MM-DD date", nil
+			}
+		case "mediatype":
+			mediaType, _, err := mime.ParseMediaType(value)
+			if err != nil || !strings.Contains(mediaType, "/") {
+				return "must be a media type such as application/pdf", nil
+			}
+		case "oneof":
+			options := strings.Split(arg, "|")
+			found := false
+			for _, option := range options {
+				if value == option {
+					found = true
+				}
+			}
+			if !found {
+				return fmt.Sprintf("must be one of %s", strings.Join(options, ", ")), nil
+			}
+		default:
+			return "", fmt.Errorf("unknown rule %q", name)
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hyperledger/fabric-gateway/pkg/client"
+)
+
+// ContractEvent holds the fields shared by the CarEvent, OrderEvent and MatchingEvent payloads of the chaincode
+type ContractEvent struct {
+	Type      string          `json:"type"`
+	CarId     string          `json:"carId,omitempty"`
+	OrderID   string          `json:"orderID,omitempty"`
+	Car       json.RawMessage `json:"car,omitempty"`
+	Matches   []*OrderMatch   `json:"matches,omitempty"`
+	TxId      string          `json:"txId"`
+	Timestamp string          `json:"timestamp"`
+}
+
+// OrderMatch is a car and the order it was matched to by a RunMatching batch
+type OrderMatch struct {
+	OrderID string `json:"orderID"`
+	CarId   string `json:"carId"`
+}
+
+// listenForEvents streams chaincode events to handle until ctx is cancelled. Progress is recorded
+// in checkpointFile after each event is handled, so a restarted listener resumes where it stopped.
+func listenForEvents(ctx context.Context, organization string, channelName string, chaincodeName string, checkpointFile string, handle func(*ContractEvent) error) error {
+	checkpointer, err := client.NewFileCheckpointer(checkpointFile)
+	if err != nil {
+		return fmt.Errorf("failed to open checkpoint file: %w", err)
+	}
+	defer checkpointer.Close()
+
+	gwConfig, err := initializeGateway(organization, channelName, chaincodeName, "")
+	if err != nil {
+		return err
+	}
+	defer gwConfig.gateway.Close()
+
+	for ctx.Err() == nil {
+		err = readEvents(ctx, gwConfig.network, chaincodeName, checkpointer, handle)
+		if err != nil {
+			return err
+		}
+
+		// The event stream was interrupted, reconnect from the last checkpoint
+		select {
+		case <-ctx.Done():
+		case <-time.After(5 * time.Second):
+			fmt.Printf("Reconnecting to event stream from block %d\n", checkpointer.BlockNumber())
+		}
+	}
+	return nil
+}
+
+func readEvents(ctx context.Context, network *client.Network, chaincodeName string, checkpointer *client.FileCheckpointer, handle func(*ContractEvent) error) error {
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events, err := network.ChaincodeEvents(streamCtx, chaincodeName, client.WithCheckpoint(checkpointer))
+	if err != nil {
+		return fmt.Errorf("failed to start chaincode event listening: %w", err)
+	}
+
+	for event := range events {
+		var payload ContractEvent
+		err = json.Unmarshal(event.Payload, &payload)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s event in transaction %s: %w", event.EventName, event.TransactionID, err)
+		}
+
+		err = handle(&payload)
+		if err != nil {
+			return err
+		}
+
+		err = checkpointer.CheckpointChaincodeEvent(event)
+		if err != nil {
+			return fmt.Errorf("failed to checkpoint event: %w", err)
+		}
+	}
+	return nil
+}
+
+func printEvent(event *ContractEvent) error {
+	fmt.Printf("<-- %s at %s car: %s order: %s txId: %s\n", event.Type, event.Timestamp, event.CarId, event.OrderID, event.TxId)
+	for _, match := range event.Matches {
+		fmt.Printf("    car: %s order: %s\n", match.CarId, match.OrderID)
+	}
+	return nil
+}
@@ -4,12 +4,12 @@ go 1.21.3
 
 require (
 	github.com/hyperledger/fabric-gateway v1.4.0
+	github.com/hyperledger/fabric-protos-go-apiv2 v0.2.1
 	google.golang.org/grpc v1.59.0
 )
 
 require (
 	github.com/golang/protobuf v1.5.3 // indirect
-	github.com/hyperledger/fabric-protos-go-apiv2 v0.2.1 // indirect
 	github.com/miekg/pkcs11 v1.1.1 // indirect
 	golang.org/x/crypto v0.14.0 // indirect
 	golang.org/x/net v0.17.0 // indirect
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/hyperledger/fabric-gateway/pkg/client"
+)
+
+// OrderRequest is the order CreateOrder accepts as JSON under the "order" transient key
+type OrderRequest struct {
+	Make         string   `json:"make"`
+	Model        string   `json:"model"`
+	Color        string   `json:"color"`
+	DealerName   string   `json:"dealerName"`
+	Quantity     int      `json:"quantity,omitempty"`
+	Options      []string `json:"options,omitempty"`
+	DeliveryDate string   `json:"deliveryDate,omitempty"`
+	Notes        string   `json:"notes,omitempty"`
+}
+
+// orderTransient builds the transient map that carries an order to CreateOrder, keeping its
+// details out of the transaction arguments and off the ledger
+func orderTransient(order *OrderRequest) (map[string][]byte, error) {
+	data, err := json.Marshal(order)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal order: %w", err)
+	}
+	return map[string][]byte{"order": data}, nil
+}
+
+// createOrder submits an order to the order collection
+func createOrder(contract *client.Contract, orderID string, order *OrderRequest) error {
+	transient, err := orderTransient(order)
+	if err != nil {
+		return err
+	}
+
+	_, err = contract.Submit("CreateOrder", client.WithArguments(orderID), client.WithTransient(transient))
+	if err != nil {
+		return fmt.Errorf("failed to create order %s: %w", orderID, err)
+	}
+	return nil
+}
+
+// runOrderCommand handles "order <orderID> <file>", placing the order in the JSON file as the
+// dealer, and "retention", purging the dealer's orders past the retention period
+func runOrderCommand(args []string) error {
+	gwConfig, err := initializeGateway("dealer", "autochannel", "KBA-Automobile", "OrderContract")
+	if err != nil {
+		return err
+	}
+	defer gwConfig.gateway.Close()
+
+	if args[0] == "retention" && len(args) == 1 {
+		purged, err := purgeExpiredOrders(gwConfig.contract, 50)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("*** Purged %d orders past retention\n", purged)
+		return nil
+	}
+	if args[0] != "order" || len(args) != 3 {
+		return fmt.Errorf("usage: order <orderID> <file> | retention")
+	}
+
+	data, err := os.ReadFile(args[2])
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", args[2], err)
+	}
+
+	var order OrderRequest
+	err = json.Unmarshal(data, &order)
+	if err != nil {
+		return fmt.Errorf("failed to parse %s: %w", args[2], err)
+	}
+
+	err = createOrder(gwConfig.contract, args[1], &order)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("*** Order %s created\n", args[1])
+	return nil
+}
+
+// purgeExpiredOrders is the retention job: it scans the order collection in batches and purges the
+// dealer's orders past the retention period, until the whole collection is scanned. It returns the
+// number of purged orders.
+func purgeExpiredOrders(contract *client.Contract, batchSize int) (int, error) {
+	purged := 0
+	startKey := ""
+	for {
+		result, err := contract.EvaluateTransaction("GetOrdersPastRetention", startKey, strconv.Itoa(batchSize))
+		if err != nil {
+			return purged, fmt.Errorf("failed to list orders past retention: %w", err)
+		}
+
+		var batch struct {
+			Orders []struct {
+				OrderID string `json:"orderID"`
+			} `json:"orders"`
+			NextKey string `json:"nextKey"`
+		}
+		err = json.Unmarshal(result, &batch)
+		if err != nil {
+			return purged, fmt.Errorf("failed to parse orders past retention: %w", err)
+		}
+
+		for _, order := range batch.Orders {
+			// An order purged by an earlier, interrupted run is already gone
+			_, err = contract.SubmitTransaction("PurgeOrder", order.OrderID)
+			if err != nil && errorCode(err) != CodeNotFound {
+				return purged, fmt.Errorf("failed to purge order %s: %w", order.OrderID, err)
+			}
+			purged++
+		}
+
+		if batch.NextKey == "" {
+			return purged, nil
+		}
+		startKey = batch.NextKey
+	}
+}
@@ -1,9 +1,9 @@
 package contracts
 
 import (
-	"encoding/json"
 	"fmt"
-	"time"
+
+	"kbaauto/query"
 
 	"github.com/hyperledger/fabric-chaincode-go/shim"
 	"github.com/hyperledger/fabric-contract-api-go/contractapi"
@@ -19,271 +19,323 @@ type PaginatedQueryResult struct {
 	Bookmark            string `json:"bookmark"`
 }
 
-type HistoryQueryResult struct {
-	Record    *Car   `json:"record"`
-	TxId      string `json:"txId"`
-	Timestamp string `json:"timestamp"`
-	IsDelete  bool   `json:"isDelete"`
-}
-
 type Car struct {
-	AssetType         string `json:"assetType"`
-	CarId             string `json:"carId"`
-	Color             string `json:"color"`
-	DateOfManufacture string `json:"dateOfManufacture"`
-	Make              string `json:"make"`
-	Model             string `json:"model"`
-	OwnedBy           string `json:"ownedBy"`
-	Status            string `json:"status"`
+	AssetType          string       `json:"assetType"`
+	CarId              string       `json:"carId" validate:"required,max=64,charset=id"`
+	Color              string       `json:"color" validate:"required,max=32,charset=name"`
+	DateOfManufacture  string       `json:"dateOfManufacture" validate:"required,date"`
+	Make               string       `json:"make" validate:"required,max=64,charset=name"`
+	Model              string       `json:"model" validate:"required,max=64,charset=name"`
+	OwnedBy            string       `json:"ownedBy" validate:"required,max=128,charset=name"`
+	Status             CarStatus    `json:"status" validate:"oneof=Manufactured|InTransit|AtDealer|Sold|Registered|Scrapped"`
+	RegisteredOwner    string       `json:"registeredOwner,omitempty" metadata:",optional" validate:"max=128,charset=name"`
+	OwnerCommitment    string       `json:"ownerCommitment,omitempty" metadata:",optional"`
+	RegistrationNumber string       `json:"registrationNumber,omitempty" metadata:",optional" validate:"max=16,charset=plate"`
+	Owner              *Identity    `json:"owner,omitempty" metadata:",optional"`
+	Version            int64        `json:"version"`
+	Archive            *ArchiveInfo `json:"archive,omitempty" metadata:",optional"`
+	WMI                string       `json:"wmi,omitempty" metadata:",optional"`
+	ModelYear          int          `json:"modelYear,omitempty" metadata:",optional"`
+	Documents          []*Document  `json:"documents,omitempty" metadata:",optional"`
 }
 
 // CarExists returns true when asset with given ID exists in world state
 func (c *CarContract) CarExists(ctx contractapi.TransactionContextInterface, carID string) (bool, error) {
+	err := checkAccess(ctx, "CarContract:CarExists")
+	if err != nil {
+		return false, err
+	}
+
+	return c.carExists(ctx, carID)
+}
+
+func (c *CarContract) carExists(ctx contractapi.TransactionContextInterface, carID string) (bool, error) {
 	data, err := ctx.GetStub().GetState(carID)
 
 	if err != nil {
-		return false, fmt.Errorf("failed to read from world state: %v", err)
+		return false, errorf(CodeInternal, "failed to read from world state: %v", err)
 	}
 	return data != nil, nil
 }
 
 // CreateCar creates a new instance of Car
 func (c *CarContract) CreateCar(ctx contractapi.TransactionContextInterface, carID string, make string, model string, color string, manufacturerName string, dateOfManufacture string) (string, error) {
+	err := checkAccess(ctx, "CarContract:CreateCar")
+	if err != nil {
+		return "", err
+	}
+
 	clientOrgID, err := ctx.GetClientIdentity().GetMSPID()
 
 	if err != nil {
-		return "", fmt.Errorf("could not fetch client identity. %s", err)
+		return "", errorf(CodeInternal, "could not fetch client identity. %s", err)
 	}
 
 	val, ok, err := ctx.GetClientIdentity().GetAttributeValue("hf.EnrollmentID")
 
 	if !ok {
-		return "", fmt.Errorf("could not fetch Attribute value. %s", err)
+		return "", errorf(CodeInternal, "could not fetch Attribute value. %s", err)
 	}
 
-	if clientOrgID == "ManufacturerMSP" {
+	exists, err := c.carExists(ctx, carID)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not fetch the details from world state.%s", err)
+	} else if exists {
+		return "", errorf(CodeAlreadyExists, "the car, %s already exists", carID)
+	}
+
+	car := Car{
+		AssetType:         "car",
+		CarId:             carID,
+		Color:             color,
+		DateOfManufacture: dateOfManufacture,
+		Make:              make,
+		Model:             model,
+		OwnedBy:           manufacturerName,
+		Status:            StatusManufactured,
+		Owner:             &Identity{MSPID: clientOrgID, EnrollmentID: val},
+		Version:           1,
+	}
 
-		// role, found, err := ctx.GetClientIdentity().GetAttributeValue("role")
-		// if err != nil {
-		// 	return fmt.Errorf("failed to get attribute 'role': %v", err)
-		// }
+	err = validateCar(&car)
+	if err != nil {
+		return "", err
+	}
 
-		// if !found || role != "user1" {
-		// 	return fmt.Errorf("unauthorized user: must have 'role=user1'")
-		// }
-		// if clientOrgID == "Org1MSP" {
-		//if clientOrgID == "manufacturer-auto-com" {
+	err = checkCarIdentity(ctx, &car)
+	if err != nil {
+		return "", err
+	}
 
-		exists, err := c.CarExists(ctx, carID)
-		if err != nil {
-			return "", fmt.Errorf("could not fetch the details from world state.%s", err)
-		} else if exists {
-			return "", fmt.Errorf("the car, %s already exists", carID)
-		}
+	fmt.Println("Create car data ======= ", car)
+	bytes, err := marshalCar(ctx, &car)
+	if err != nil {
+		return "", err
+	}
 
+	err = ctx.GetStub().PutState(carID, bytes)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not create car. %s", err)
+	}
 
-		car := Car{
-			AssetType:         "car",
-			CarId:             carID,
-			Color:             color,
-			DateOfManufacture: dateOfManufacture,
-			Make:              make,
-			Model:             model,
-			OwnedBy:           manufacturerName,
-			Status:            "In Factory",
-		}
-		fmt.Println("Create car data ======= ", car)
-		bytes, _ := json.Marshal(car)
+	err = applyEndorsementTemplate(ctx, &car)
+	if err != nil {
+		return "", err
+	}
 
-		err = ctx.GetStub().PutState(carID, bytes)
+	config, err := readConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+	orderID := ""
+	if config.AutoMatch {
+		decision, err := c.autoMatch(ctx, &car, MatchAuto, config, map[string]*Order{})
 		if err != nil {
-			return "", fmt.Errorf("could not create car. %s", err)
-		} else {
-			return fmt.Sprintf("successfully added car %v  Enrollment ID is %v", carID, val), nil
+			return "", err
+		}
+		if decision != nil {
+			orderID = decision.OrderID
 		}
+	}
 
-	} else {
-		return "", fmt.Errorf("user under following MSPID: %v can't perform this action", clientOrgID)
+	err = emitMatchedCarEvent(ctx, EventCarCreated, carID, &car, orderID)
+	if err != nil {
+		return "", err
 	}
+	return fmt.Sprintf("successfully added car %v  Enrollment ID is %v", carID, val), nil
 }
 
 // ReadCar retrieves an instance of Car from the world state
 func (c *CarContract) ReadCar(ctx contractapi.TransactionContextInterface, carID string) (*Car, error) {
-
-	bytes, err := ctx.GetStub().GetState(carID)
+	err := checkAccess(ctx, "CarContract:ReadCar")
 	if err != nil {
-		return nil, fmt.Errorf("failed to read from world state: %v", err)
-	}
-	if bytes == nil {
-		return nil, fmt.Errorf("the car %s does not exist", carID)
+		return nil, err
 	}
 
-	policy := []byte("OutOf(2,'ManufacturerMSP.member', 'DealerMSP.member','MvdMSP.member')")
-
+	return c.readCar(ctx, carID)
+}
 
-	err = ctx.GetStub().SetStateValidationParameter(carID,policy)
+func (c *CarContract) readCar(ctx contractapi.TransactionContextInterface, carID string) (*Car, error) {
 
+	bytes, err := ctx.GetStub().GetState(carID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to set endorsement policy: %s", err)
+		return nil, errorf(CodeInternal, "failed to read from world state: %v", err)
+	}
+	if bytes == nil {
+		return nil, errorf(CodeNotFound, "the car %s does not exist", carID)
 	}
 
 	var car Car
 
-	err = json.Unmarshal(bytes, &car)
+	err = unmarshalCar(bytes, &car)
 
 	if err != nil {
-		return nil, fmt.Errorf("could not unmarshal world state data to type Car")
+		return nil, errorf(CodeInternal, "could not unmarshal world state data to type Car")
 	}
 
 	return &car, nil
 }
 
-func (c *CarContract) EndorsementInfo(ctx contractapi.TransactionContextInterface, carID string) (string, error) {
-
-    // Define the endorsement policy for carID
-    // policy := []byte("OutOf(3,'ManufacturerMSP.peer', 'DealerMSP.peer', 'MvdMSP.peer')")
-
-    // // Set the endorsement policy for the specified carID
-    // err := ctx.GetStub().SetStateValidationParameter(carID, policy)
-    // if err != nil {
-    //     return "", fmt.Errorf("failed to set endorsement policy for %s: %s", carID, err)
-    // }
-
-    // Now, retrieve the endorsement policy to confirm it's set
-
-	var statepolicy []byte
-
-    statepolicy, err := ctx.GetStub().GetStateValidationParameter(carID)
-    if err != nil {
-        return "", fmt.Errorf("failed to get endorsement policy for %s: %s", carID, err)
-    }
+// EndorsementInfo describes the endorsement policy of a car, see GetCarEndorsementPolicy
+func (c *CarContract) EndorsementInfo(ctx contractapi.TransactionContextInterface, carID string) (*EndorsementPolicy, error) {
+	err := checkAccess(ctx, "CarContract:EndorsementInfo")
+	if err != nil {
+		return nil, err
+	}
 
-    // Return the policy if it was successfully set
-    return fmt.Sprintf("Endorsement Policy for %s is: %s", carID, string(statepolicy)), nil
+	return c.carEndorsementPolicy(ctx, carID)
 }
 
 
 func (c *CarContract) UpdateCar(ctx contractapi.TransactionContextInterface, carID string, make string, model string, color string, manufacturerName string, dateOfManufacture string) (string, error) {
-	clientOrgID, err := ctx.GetClientIdentity().GetMSPID()
-
+	err := checkAccess(ctx, "CarContract:UpdateCar")
 	if err != nil {
-		return "", fmt.Errorf("could not fetch client identity. %s", err)
+		return "", err
 	}
 
 	val, ok, err := ctx.GetClientIdentity().GetAttributeValue("hf.EnrollmentID")
 
 	if !ok {
-		return "", fmt.Errorf("could not fetch Attribute value. %s", err)
+		return "", errorf(CodeInternal, "could not fetch Attribute value. %s", err)
 	}
 
-	if clientOrgID == "ManufacturerMSP" {
+	exists, err := c.carExists(ctx, carID)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not fetch the details from world state.%s", err)
+	} else if !exists {
+		return "", errorf(CodeNotFound, "the car, %s does not exists. Create a car first then only you can update", carID)
+	}
 
-		// role, found, err := ctx.GetClientIdentity().GetAttributeValue("role")
-		// if err != nil {
-		// 	return fmt.Errorf("failed to get attribute 'role': %v", err)
-		// }
+	car, err := c.readActiveCar(ctx, carID)
+	if err != nil {
+		return "", err
+	}
 
-		// if !found || role != "user1" {
-		// 	return fmt.Errorf("unauthorized user: must have 'role=user1'")
-		// }
-		// if clientOrgID == "Org1MSP" {
-		//if clientOrgID == "manufacturer-auto-com" {
+	err = requireCarStatus(car, "updated", StatusManufactured)
+	if err != nil {
+		return "", err
+	}
 
-		exists, err := c.CarExists(ctx, carID)
-		if err != nil {
-			return "", fmt.Errorf("could not fetch the details from world state.%s", err)
-		} else if !exists {
-			return "", fmt.Errorf("the car, %s does not exists. Create a car first then only you can update", carID)
-		}
+	car.Color = color
+	car.DateOfManufacture = dateOfManufacture
+	car.Make = make
+	car.Model = model
+	car.OwnedBy = manufacturerName
 
-		car := Car{
-			AssetType:         "car",
-			CarId:             carID,
-			Color:             color,
-			DateOfManufacture: dateOfManufacture,
-			Make:              make,
-			Model:             model,
-			OwnedBy:           manufacturerName,
-			Status:            "In Factory",
-		}
-		fmt.Println("Create car data ======= ", car)
-		bytes, _ := json.Marshal(car)
+	err = validateCar(car, "make", "model", "color", "ownedBy", "dateOfManufacture")
+	if err != nil {
+		return "", err
+	}
 
-		err = ctx.GetStub().PutState(carID, bytes)
-		if err != nil {
-			return "", fmt.Errorf("could not create car. %s", err)
-		} else {
-			return fmt.Sprintf("successfully added car %v  Enrollment ID is %v", carID, val), nil
-		}
+	err = checkCarIdentity(ctx, car)
+	if err != nil {
+		return "", err
+	}
 
-	} else {
-		return "", fmt.Errorf("user under following MSPID: %v can't perform this action", clientOrgID)
+	fmt.Println("Create car data ======= ", car)
+	car.Version++
+	bytes, err := marshalCar(ctx, car)
+	if err != nil {
+		return "", err
+	}
+
+	err = ctx.GetStub().PutState(carID, bytes)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not create car. %s", err)
+	}
+
+	err = emitCarEvent(ctx, EventCarUpdated, carID, car)
+	if err != nil {
+		return "", err
 	}
+	return fmt.Sprintf("successfully added car %v  Enrollment ID is %v", carID, val), nil
 }
 
-// DeleteCar removes the instance of Car from the world state
+// DeleteCar archives the car with the reason "deleted"; PurgeCar removes it from the world state
 func (c *CarContract) DeleteCar(ctx contractapi.TransactionContextInterface, carID string) (string, error) {
 
-	clientOrgID, err := ctx.GetClientIdentity().GetMSPID()
+	err := checkAccess(ctx, "CarContract:DeleteCar")
 	if err != nil {
-		return "", fmt.Errorf("could not fetch client identity. %s", err)
+		return "", err
 	}
-	if clientOrgID == "ManufacturerMSP" {
-		// if clientOrgID == "Org1MSP" {
-		//if clientOrgID == "manufacturer-auto-com" {
 
-		exists, err := c.CarExists(ctx, carID)
-		if err != nil {
-			return "", fmt.Errorf("%s", err)
-		} else if !exists {
-			return "", fmt.Errorf("the car, %s does not exist", carID)
-		}
+	exists, err := c.carExists(ctx, carID)
+	if err != nil {
+		return "", errorf(CodeInternal, "%s", err)
+	} else if !exists {
+		return "", errorf(CodeNotFound, "the car, %s does not exist", carID)
+	}
 
-		err = ctx.GetStub().DelState(carID)
-		if err != nil {
-			return "", err
-		} else {
-			return fmt.Sprintf("car with id %v is deleted from the world state.", carID), nil
-		}
+	car, err := c.readCar(ctx, carID)
+	if err != nil {
+		return "", err
+	}
 
-	} else {
-		return "", fmt.Errorf("user under following MSPID: %v can't perform this action", clientOrgID)
+	err = c.archiveCar(ctx, car, "deleted")
+	if err != nil {
+		return "", err
 	}
+
+	err = emitCarEvent(ctx, EventCarDeleted, carID, nil)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("car with id %v is deleted and archived.", carID), nil
 }
 
 func (c *CarContract) GetCarsByRange(ctx contractapi.TransactionContextInterface, startKey, endKey string) ([]*Car, error) {
+	err := checkAccess(ctx, "CarContract:GetCarsByRange")
+	if err != nil {
+		return nil, err
+	}
+
 	resultsIterator, err := ctx.GetStub().GetStateByRange(startKey, endKey)
 	if err != nil {
-		return nil, fmt.Errorf("could not fetch the  data by range. %s", err)
+		return nil, errorf(CodeInternal, "could not fetch the  data by range. %s", err)
 	}
 	defer resultsIterator.Close()
 
-	return carResultIteratorFunction(resultsIterator)
+	cars, err := carResultIteratorFunction(resultsIterator)
+	if err != nil {
+		return nil, err
+	}
+	return unarchivedCars(cars), nil
 }
 
 func (c *CarContract) GetAllCars(ctx contractapi.TransactionContextInterface) ([]*Car, error) {
+	err := checkAccess(ctx, "CarContract:GetAllCars")
+	if err != nil {
+		return nil, err
+	}
 
-	queryString := `{"selector":{"assetType":"car"}, "sort":[{ "color": "desc"}]}`
+	queryString, err := query.New().
+		Eq("assetType", "car").
+		Exists("archive", false).
+		Sort("color", query.Descending).
+		String()
+	if err != nil {
+		return nil, errorf(CodeInternal, "%s", err)
+	}
 
 	resultsIterator, err := ctx.GetStub().GetQueryResult(queryString)
 	if err != nil {
-		return nil, fmt.Errorf("could not fetch the query result. %s", err)
+		return nil, errorf(CodeInternal, "could not fetch the query result. %s", err)
 	}
 	defer resultsIterator.Close()
 	return carResultIteratorFunction(resultsIterator)
 }
 
 func carResultIteratorFunction(resultsIterator shim.StateQueryIteratorInterface) ([]*Car, error) {
-	var cars []*Car
+	cars := []*Car{}
 	for resultsIterator.HasNext() {
 		queryResult, err := resultsIterator.Next()
 		if err != nil {
-			return nil, fmt.Errorf("could not fetch the details of the result iterator. %s", err)
+			return nil, errorf(CodeInternal, "could not fetch the details of the result iterator. %s", err)
 		}
 		var car Car
-		err = json.Unmarshal(queryResult.Value, &car)
+		err = unmarshalCar(queryResult.Value, &car)
 		if err != nil {
-			return nil, fmt.Errorf("could not unmarshal the data. %s", err)
+			return nil, errorf(CodeInternal, "could not unmarshal the data. %s", err)
 		}
 		cars = append(cars, &car)
 	}
@@ -291,62 +343,26 @@ func carResultIteratorFunction(resultsIterator shim.StateQueryIteratorInterface)
 	return cars, nil
 }
 
-func (c *CarContract) GetCarHistory(ctx contractapi.TransactionContextInterface, carID string) ([]*HistoryQueryResult, error) {
-
-	resultsIterator, err := ctx.GetStub().GetHistoryForKey(carID)
+func (c *CarContract) GetCarsWithPagination(ctx contractapi.TransactionContextInterface, pageSize int32, bookmark string) (*PaginatedQueryResult, error) {
+	err := checkAccess(ctx, "CarContract:GetCarsWithPagination")
 	if err != nil {
-		return nil, fmt.Errorf("could not get the data. %s", err)
+		return nil, err
 	}
-	defer resultsIterator.Close()
 
-	var records []*HistoryQueryResult
-	for resultsIterator.HasNext() {
-		response, err := resultsIterator.Next()
-		if err != nil {
-			return nil, fmt.Errorf("could not get the value of resultsIterator. %s", err)
-		}
-
-		var car Car
-		if len(response.Value) > 0 {
-			err = json.Unmarshal(response.Value, &car)
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			car = Car{
-				CarId: carID,
-			}
-		}
-
-		timestamp := response.Timestamp.AsTime()
-
-		formattedTime := timestamp.Format(time.RFC1123)
-
-		record := HistoryQueryResult{
-			TxId:      response.TxId,
-			Timestamp: formattedTime,
-			Record:    &car,
-			IsDelete:  response.IsDelete,
-		}
-		records = append(records, &record)
+	queryString, err := query.New().Eq("assetType", "car").Exists("archive", false).String()
+	if err != nil {
+		return nil, errorf(CodeInternal, "%s", err)
 	}
 
-	return records, nil
-}
-
-func (c *CarContract) GetCarsWithPagination(ctx contractapi.TransactionContextInterface, pageSize int32, bookmark string) (*PaginatedQueryResult, error) {
-
-	queryString := `{"selector":{"assetType":"car"}}`
-
 	resultsIterator, responseMetadata, err := ctx.GetStub().GetQueryResultWithPagination(queryString, pageSize, bookmark)
 	if err != nil {
-		return nil, fmt.Errorf("could not get the car records. %s", err)
+		return nil, errorf(CodeInternal, "could not get the car records. %s", err)
 	}
 	defer resultsIterator.Close()
 
 	cars, err := carResultIteratorFunction(resultsIterator)
 	if err != nil {
-		return nil, fmt.Errorf("could not return the car records %s", err)
+		return nil, errorf(CodeInternal, "could not return the car records %s", err)
 	}
 
 	return &PaginatedQueryResult{
@@ -358,89 +374,288 @@ func (c *CarContract) GetCarsWithPagination(ctx contractapi.TransactionContextIn
 
 // GetMatchingOrders get matching orders for car from the orders
 func (c *CarContract) GetMatchingOrders(ctx contractapi.TransactionContextInterface, carID string) ([]*Order, error) {
+	err := checkAccess(ctx, "CarContract:GetMatchingOrders")
+	if err != nil {
+		return nil, err
+	}
 
-	car, err := c.ReadCar(ctx, carID)
+	car, err := c.readCar(ctx, carID)
+	if err != nil {
+		return nil, errorf(CodeInternal, "error reading car %v", err)
+	}
+	queryString, err := query.New().
+		Eq("assetType", "Order").
+		Eq("make", car.Make).
+		Eq("model", car.Model).
+		Eq("color", car.Color).
+		String()
 	if err != nil {
-		return nil, fmt.Errorf("error reading car %v", err)
+		return nil, errorf(CodeInternal, "%s", err)
 	}
-	queryString := fmt.Sprintf(`{"selector":{"assetType":"Order","make":"%s", "model": "%s", "color":"%s"}}`, car.Make, car.Model, car.Color)
+
 	resultsIterator, err := ctx.GetStub().GetPrivateDataQueryResult(collectionName, queryString)
 
 	if err != nil {
-		return nil, fmt.Errorf("could not get the data. %s", err)
+		return nil, errorf(CodeInternal, "could not get the data. %s", err)
 	}
 	defer resultsIterator.Close()
 
-	return OrderResultIteratorFunction(resultsIterator)
+	orders, err := OrderResultIteratorFunction(resultsIterator)
+	if err != nil {
+		return nil, err
+	}
 
+	now, err := txTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return openOrders(orders, now), nil
 }
 
 // MatchOrder matches car with matching order
 func (c *CarContract) MatchOrder(ctx contractapi.TransactionContextInterface, carID string, orderID string) (string, error) {
+	err := checkAccess(ctx, "CarContract:MatchOrder")
+	if err != nil {
+		return "", err
+	}
 
-	bytes, err := ctx.GetStub().GetPrivateData(collectionName, orderID)
+	order, err := readOrder(ctx, orderID)
 	if err != nil {
-		return "", fmt.Errorf("could not get the private data: %s", err)
+		return "", err
 	}
 
-	var order Order
+	now, err := txTime(ctx)
+	if err != nil {
+		return "", err
+	}
+	if order.Status == OrderOpen && order.expired(now) {
+		return "", errorf(CodeConflict, "the order %s expired at %s", orderID, order.ExpiresAt)
+	}
 
-	err = json.Unmarshal(bytes, &order)
+	car, err := c.readActiveCar(ctx, carID)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not read the data. %s", err)
+	}
 
+	_, err = c.matchCar(ctx, car, order, MatchManual, 1, 0)
 	if err != nil {
-		return "", fmt.Errorf("could not unmarshal the data. %s", err)
+		return "", err
 	}
 
-	car, err := c.ReadCar(ctx, carID)
+	err = emitOrderEvent(ctx, EventOrderMatched, orderID, carID)
 	if err != nil {
-		return "", fmt.Errorf("could not read the data. %s", err)
+		return "", err
 	}
+	return fmt.Sprintf("Matched order %v and Assigned %v to %v", orderID, car.CarId, order.DealerName), nil
+}
 
-	if car.Make == order.Make && car.Color == order.Color && car.Model == order.Model {
-		car.OwnedBy = order.DealerName
-		car.Status = "assigned to a dealer"
+// RegisterCar register car to the buyer. The owner is passed as JSON {"name","salt"} under the
+// "owner" key of the transient map and kept in the MVD owner collection; the car only carries a
+// salted hash of the name, so the owner can later prove ownership with ProveOwnership.
+func (c *CarContract) RegisterCar(ctx contractapi.TransactionContextInterface, carID string, registrationNumber string) (string, error) {
+	err := checkAccess(ctx, "CarContract:RegisterCar")
+	if err != nil {
+		return "", err
+	}
 
-		bytes, _ := json.Marshal(car)
+	err = requirePlate(registrationNumber)
+	if err != nil {
+		return "", err
+	}
 
-		ctx.GetStub().DelPrivateData(collectionName, orderID)
+	owner, err := readOwnerSecret(ctx)
+	if err != nil {
+		return "", err
+	}
 
-		err = ctx.GetStub().PutState(carID, bytes)
+	car, err := c.readActiveCar(ctx, carID)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not read the data. %s", err)
+	}
 
-		if err != nil {
-			return "", fmt.Errorf("could not add the data %s", err)
-		} else {
-			return fmt.Sprintf("Deleted order %v and Assigned %v to %v", orderID, car.CarId, order.DealerName), nil
-		}
-	} else {
-		return "", fmt.Errorf("order is not matching")
+	err = transitionCar(car, StatusRegistered)
+	if err != nil {
+		return "", err
 	}
+	car.RegistrationNumber = registrationNumber
+
+	err = validateCar(car, "registrationNumber")
+	if err != nil {
+		return "", err
+	}
+
+	err = recordOwner(ctx, car, owner)
+	if err != nil {
+		return "", err
+	}
+
+	car.Version++
+	bytes, err := marshalCar(ctx, car)
+	if err != nil {
+		return "", err
+	}
+	err = ctx.GetStub().PutState(carID, bytes)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not add the updated car details %s", err)
+	}
+
+	err = claimPlate(ctx, car)
+	if err != nil {
+		return "", err
+	}
+
+	err = applyEndorsementTemplate(ctx, car)
+	if err != nil {
+		return "", err
+	}
+
+	err = emitCarEvent(ctx, EventCarRegistered, carID, car)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Car %v successfully registered as %v", carID, registrationNumber), nil
 }
 
-// RegisterCar register car to the buyer
-func (c *CarContract) RegisterCar(ctx contractapi.TransactionContextInterface, carID string, ownerName string, registrationNumber string) (string, error) {
-	clientOrgID, err := ctx.GetClientIdentity().GetMSPID()
+// ShipCar marks a car as having left the factory for a dealer
+func (c *CarContract) ShipCar(ctx contractapi.TransactionContextInterface, carID string) (string, error) {
+	err := checkAccess(ctx, "CarContract:ShipCar")
 	if err != nil {
-		return "", fmt.Errorf("could not get the MSPID %s", err)
+		return "", err
 	}
 
-	if clientOrgID == "MvdMSP" {
-		// if clientOrgID == "Org3MSP" {
-		//if clientOrgID == "mvd-auto-com" {
+	car, err := c.readActiveCar(ctx, carID)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not read the data. %s", err)
+	}
 
-		car, _ := c.ReadCar(ctx, carID)
-		car.Status = fmt.Sprintf("Registered to  %v with plate number %v", ownerName, registrationNumber)
-		car.OwnedBy = ownerName
+	err = transitionCar(car, StatusInTransit)
+	if err != nil {
+		return "", err
+	}
 
-		bytes, _ := json.Marshal(car)
-		err = ctx.GetStub().PutState(carID, bytes)
-		if err != nil {
-			return "", fmt.Errorf("could not add the updated car details %s", err)
-		} else {
-			return fmt.Sprintf("Car %v successfully registered to %v", carID, ownerName), nil
-		}
+	car.Version++
+	bytes, err := marshalCar(ctx, car)
+	if err != nil {
+		return "", err
+	}
+	err = ctx.GetStub().PutState(carID, bytes)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not add the updated car details %s", err)
+	}
 
-	} else {
-		return "", fmt.Errorf("user under following MSPID: %v cannot able to perform this action", clientOrgID)
+	err = applyEndorsementTemplate(ctx, car)
+	if err != nil {
+		return "", err
+	}
+
+	err = emitCarEvent(ctx, EventCarUpdated, carID, car)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Car %v is in transit", carID), nil
+}
+
+// SellCar records the sale of a car by the dealer holding it. The buyer is passed as JSON
+// {"name","salt"} under the "owner" key of the transient map and kept in the MVD owner collection;
+// the car only carries the salted hash of the name, as it does once registered.
+func (c *CarContract) SellCar(ctx contractapi.TransactionContextInterface, carID string) (string, error) {
+	err := checkAccess(ctx, "CarContract:SellCar")
+	if err != nil {
+		return "", err
+	}
+
+	buyer, err := readOwnerSecret(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	car, err := c.readActiveCar(ctx, carID)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not read the data. %s", err)
+	}
+
+	// A registered car also moves to Sold, but only when the MVD deregisters it
+	err = requireCarStatus(car, "sold", StatusAtDealer)
+	if err != nil {
+		return "", err
+	}
+	err = transitionCar(car, StatusSold)
+	if err != nil {
+		return "", err
+	}
+
+	err = recordOwner(ctx, car, buyer)
+	if err != nil {
+		return "", err
+	}
+
+	car.Version++
+	bytes, err := marshalCar(ctx, car)
+	if err != nil {
+		return "", err
+	}
+	err = ctx.GetStub().PutState(carID, bytes)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not add the updated car details %s", err)
+	}
+
+	err = applyEndorsementTemplate(ctx, car)
+	if err != nil {
+		return "", err
+	}
+
+	err = emitCarEvent(ctx, EventCarUpdated, carID, car)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Car %v sold", carID), nil
+}
+
+// ScrapCar takes a car permanently off the road
+func (c *CarContract) ScrapCar(ctx contractapi.TransactionContextInterface, carID string) (string, error) {
+	err := checkAccess(ctx, "CarContract:ScrapCar")
+	if err != nil {
+		return "", err
 	}
 
+	car, err := c.readActiveCar(ctx, carID)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not read the data. %s", err)
+	}
+
+	err = transitionCar(car, StatusScrapped)
+	if err != nil {
+		return "", err
+	}
+
+	// A scrapped car gives up its registration number and owner
+	err = releasePlate(ctx, car)
+	if err != nil {
+		return "", err
+	}
+	err = releaseOwner(ctx, car)
+	if err != nil {
+		return "", err
+	}
+
+	car.Version++
+	bytes, err := marshalCar(ctx, car)
+	if err != nil {
+		return "", err
+	}
+	err = ctx.GetStub().PutState(carID, bytes)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not add the updated car details %s", err)
+	}
+
+	err = applyEndorsementTemplate(ctx, car)
+	if err != nil {
+		return "", err
+	}
+
+	err = emitCarEvent(ctx, EventCarUpdated, carID, car)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Car %v is scrapped", carID), nil
 }
@@ -0,0 +1,229 @@
+package contracts
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"kbaauto/validate"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+const (
+	// ownerCollectionName is readable by the MVD only
+	ownerCollectionName string = "OwnerCollection"
+
+	// transientOwnerKey is the transient map key of the owner of a car
+	transientOwnerKey string = "owner"
+
+	// minOwnerSaltLength keeps commitments from being brute forced over a list of names
+	minOwnerSaltLength = 16
+)
+
+// ownerSecret is the owner identity passed in the transient map. The MVD picks the salt and
+// hands it to the owner, who needs it to prove ownership later.
+type ownerSecret struct {
+	Name string `json:"name" validate:"required,max=128,charset=name"`
+	Salt string `json:"salt" validate:"required,max=128"`
+}
+
+// OwnerRecord is the registered owner of a car, kept in the MVD owner collection
+type OwnerRecord struct {
+	AssetType    string `json:"assetType"`
+	CarId        string `json:"carId"`
+	Name         string `json:"name"`
+	Salt         string `json:"salt"`
+	Commitment   string `json:"commitment"`
+	RegisteredAt string `json:"registeredAt"`
+}
+
+// OwnershipProof is the result of checking a claimed owner against the commitment on a car
+type OwnershipProof struct {
+	CarId    string `json:"carId"`
+	Verified bool   `json:"verified"`
+}
+
+// ownerCommitment is the salted hash of the owner name published on the car
+func ownerCommitment(name string, salt string) string {
+	sum := sha256.Sum256([]byte(salt + "\x00" + name))
+	return hex.EncodeToString(sum[:])
+}
+
+// readOwnerSecret decodes and checks the owner in the transient map
+func readOwnerSecret(ctx contractapi.TransactionContextInterface) (*ownerSecret, error) {
+	transientData, err := ctx.GetStub().GetTransient()
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not fetch transient data. %s", err)
+	}
+
+	data, exists := transientData[transientOwnerKey]
+	if !exists {
+		return nil, errorf(CodeInvalidArgument, "the owner was not specified in transient data. Please try again")
+	}
+
+	var owner ownerSecret
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	err = decoder.Decode(&owner)
+	if err != nil {
+		return nil, errorf(CodeInvalidArgument, "could not parse the owner. %s", err)
+	}
+
+	err = validate.Struct(&owner)
+	if err != nil {
+		return nil, errorf(CodeInvalidArgument, "invalid owner. %s", err)
+	}
+	if len(owner.Salt) < minOwnerSaltLength {
+		return nil, errorf(CodeInvalidArgument, "invalid owner. salt must have at least %d characters", minOwnerSaltLength)
+	}
+	return &owner, nil
+}
+
+// recordOwner stores the owner in the owner collection and publishes only its commitment on the car
+func recordOwner(ctx contractapi.TransactionContextInterface, car *Car, owner *ownerSecret) error {
+	now, err := txTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	record := OwnerRecord{
+		AssetType:    "owner",
+		CarId:        car.CarId,
+		Name:         owner.Name,
+		Salt:         owner.Salt,
+		Commitment:   ownerCommitment(owner.Name, owner.Salt),
+		RegisteredAt: now.Format(time.RFC3339),
+	}
+
+	bytes, _ := json.Marshal(record)
+	err = ctx.GetStub().PutPrivateData(ownerCollectionName, car.CarId, bytes)
+	if err != nil {
+		return errorf(CodeInternal, "could not record the owner of car %s. %s", car.CarId, err)
+	}
+
+	car.OwnedBy = record.Commitment
+	car.OwnerCommitment = record.Commitment
+	car.RegisteredOwner = ""
+	return nil
+}
+
+// releaseOwner purges the owner of a car that leaves registration, along with its history on the peers
+func releaseOwner(ctx contractapi.TransactionContextInterface, car *Car) error {
+	if car.OwnerCommitment == "" {
+		return nil
+	}
+
+	err := ctx.GetStub().PurgePrivateData(ownerCollectionName, car.CarId)
+	if err != nil {
+		return errorf(CodeInternal, "could not purge the owner of car %s. %s", car.CarId, err)
+	}
+	car.OwnerCommitment = ""
+	return nil
+}
+
+// GetCarOwner returns the registered owner of a car from the owner collection
+func (c *CarContract) GetCarOwner(ctx contractapi.TransactionContextInterface, carID string) (*OwnerRecord, error) {
+	err := checkAccess(ctx, "CarContract:GetCarOwner")
+	if err != nil {
+		return nil, err
+	}
+
+	bytes, err := ctx.GetStub().GetPrivateData(ownerCollectionName, carID)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not get the private data. %s", err)
+	}
+	if bytes == nil {
+		return nil, errorf(CodeNotFound, "the car %s has no registered owner", carID)
+	}
+
+	var record OwnerRecord
+	err = json.Unmarshal(bytes, &record)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not unmarshal private data collection data to type OwnerRecord")
+	}
+	return &record, nil
+}
+
+// ProveOwnership checks the name and salt passed as JSON under the "owner" key of the transient
+// map against the owner commitment on the car. Any organisation can run it; the name never
+// reaches the ledger.
+func (c *CarContract) ProveOwnership(ctx contractapi.TransactionContextInterface, carID string) (*OwnershipProof, error) {
+	err := checkAccess(ctx, "CarContract:ProveOwnership")
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := readOwnerSecret(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	car, err := c.readCar(ctx, carID)
+	if err != nil {
+		return nil, err
+	}
+	if car.OwnerCommitment == "" {
+		return nil, errorf(CodeConflict, "the car %s has no registered owner", carID)
+	}
+
+	return &OwnershipProof{
+		CarId:    carID,
+		Verified: ownerCommitment(owner.Name, owner.Salt) == car.OwnerCommitment,
+	}, nil
+}
+
+// MigrateCarOwner moves the owner name of a car registered before owners were kept private into the
+// owner collection. The name and a new salt are passed as JSON under the "owner" key of the transient
+// map and must match the registered owner. Earlier versions of the car keep the name in its history.
+func (c *CarContract) MigrateCarOwner(ctx contractapi.TransactionContextInterface, carID string) (string, error) {
+	err := checkAccess(ctx, "CarContract:MigrateCarOwner")
+	if err != nil {
+		return "", err
+	}
+
+	owner, err := readOwnerSecret(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	car, err := c.readActiveCar(ctx, carID)
+	if err != nil {
+		return "", err
+	}
+	// The first chaincode version kept the owner name in OwnedBy only
+	registeredOwner := car.RegisteredOwner
+	if registeredOwner == "" && car.Status == StatusRegistered && car.OwnerCommitment == "" {
+		registeredOwner = car.OwnedBy
+	}
+	if registeredOwner == "" {
+		return "", errorf(CodeConflict, "the car %s has no registered owner to migrate", carID)
+	}
+	if registeredOwner != owner.Name {
+		return "", errorf(CodeInvalidArgument, "the owner does not match the registered owner of car %s", carID)
+	}
+
+	err = recordOwner(ctx, car, owner)
+	if err != nil {
+		return "", err
+	}
+
+	car.Version++
+	bytes, err := marshalCar(ctx, car)
+	if err != nil {
+		return "", err
+	}
+	err = ctx.GetStub().PutState(carID, bytes)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not add the updated car details %s", err)
+	}
+
+	err = emitCarEvent(ctx, EventCarUpdated, carID, car)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("owner of car %v moved to the owner collection", carID), nil
+}
@@ -0,0 +1,234 @@
+package contracts
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+const plateKeyPrefix string = "plate"
+
+// plateRecord assigns a registration number to a car; there is at most one per number on the channel
+type plateRecord struct {
+	AssetType          string `json:"assetType"`
+	RegistrationNumber string `json:"registrationNumber"`
+	CarId              string `json:"carId"`
+}
+
+// normalizePlate makes registration numbers that differ only in case, spaces or dashes the same
+func normalizePlate(registrationNumber string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(registrationNumber))
+}
+
+// requirePlate fails unless the registration number has something left once normalized
+func requirePlate(registrationNumber string) error {
+	if normalizePlate(registrationNumber) == "" {
+		return errorf(CodeInvalidArgument, "the registration number must have at least one letter or digit")
+	}
+	return nil
+}
+
+func plateKey(ctx contractapi.TransactionContextInterface, registrationNumber string) (string, error) {
+	key, err := ctx.GetStub().CreateCompositeKey(plateKeyPrefix, []string{normalizePlate(registrationNumber)})
+	if err != nil {
+		return "", errorf(CodeInternal, "could not create the plate key. %s", err)
+	}
+	return key, nil
+}
+
+func readPlate(ctx contractapi.TransactionContextInterface, registrationNumber string) (*plateRecord, string, error) {
+	key, err := plateKey(ctx, registrationNumber)
+	if err != nil {
+		return nil, "", err
+	}
+
+	bytes, err := ctx.GetStub().GetState(key)
+	if err != nil {
+		return nil, "", errorf(CodeInternal, "failed to read from world state: %v", err)
+	}
+	if bytes == nil {
+		return nil, key, nil
+	}
+
+	var plate plateRecord
+	err = json.Unmarshal(bytes, &plate)
+	if err != nil {
+		return nil, "", errorf(CodeInternal, "could not unmarshal world state data to type plateRecord")
+	}
+	return &plate, key, nil
+}
+
+// claimPlate assigns the registration number of a car to it, unless another car holds the number
+func claimPlate(ctx contractapi.TransactionContextInterface, car *Car) error {
+	plate, key, err := readPlate(ctx, car.RegistrationNumber)
+	if err != nil {
+		return err
+	}
+	if plate != nil && plate.CarId != car.CarId {
+		return errorf(CodeAlreadyExists, "the registration number %s is already assigned to the car %s", car.RegistrationNumber, plate.CarId)
+	}
+
+	bytes, _ := json.Marshal(plateRecord{
+		AssetType:          plateKeyPrefix,
+		RegistrationNumber: normalizePlate(car.RegistrationNumber),
+		CarId:              car.CarId,
+	})
+	err = ctx.GetStub().PutState(key, bytes)
+	if err != nil {
+		return errorf(CodeInternal, "could not assign the registration number. %s", err)
+	}
+	return nil
+}
+
+// releasePlate frees the registration number of a car and clears it on the car
+func releasePlate(ctx contractapi.TransactionContextInterface, car *Car) error {
+	if car.RegistrationNumber == "" {
+		return nil
+	}
+
+	plate, key, err := readPlate(ctx, car.RegistrationNumber)
+	if err != nil {
+		return err
+	}
+	if plate != nil && plate.CarId == car.CarId {
+		err = ctx.GetStub().DelState(key)
+		if err != nil {
+			return errorf(CodeInternal, "could not release the registration number. %s", err)
+		}
+	}
+
+	car.RegistrationNumber = ""
+	return nil
+}
+
+// GetCarByPlate returns the car a registration number is assigned to
+func (c *CarContract) GetCarByPlate(ctx contractapi.TransactionContextInterface, registrationNumber string) (*Car, error) {
+	err := checkAccess(ctx, "CarContract:GetCarByPlate")
+	if err != nil {
+		return nil, err
+	}
+
+	plate, _, err := readPlate(ctx, registrationNumber)
+	if err != nil {
+		return nil, err
+	}
+	if plate == nil {
+		return nil, errorf(CodeNotFound, "the registration number %s is not assigned to any car", registrationNumber)
+	}
+
+	return c.readCar(ctx, plate.CarId)
+}
+
+// ReplateCar gives a registered car a new registration number and frees the old one
+func (c *CarContract) ReplateCar(ctx contractapi.TransactionContextInterface, carID string, registrationNumber string) (string, error) {
+	err := checkAccess(ctx, "CarContract:ReplateCar")
+	if err != nil {
+		return "", err
+	}
+
+	err = requirePlate(registrationNumber)
+	if err != nil {
+		return "", err
+	}
+
+	car, err := c.readActiveCar(ctx, carID)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not read the data. %s", err)
+	}
+
+	err = requireCarStatus(car, "replated", StatusRegistered)
+	if err != nil {
+		return "", err
+	}
+	if normalizePlate(registrationNumber) == normalizePlate(car.RegistrationNumber) {
+		return "", errorf(CodeConflict, "the car %s already has the registration number %s", carID, car.RegistrationNumber)
+	}
+
+	err = releasePlate(ctx, car)
+	if err != nil {
+		return "", err
+	}
+	car.RegistrationNumber = registrationNumber
+
+	err = validateCar(car, "registrationNumber")
+	if err != nil {
+		return "", err
+	}
+
+	car.Version++
+	bytes, err := marshalCar(ctx, car)
+	if err != nil {
+		return "", err
+	}
+	err = ctx.GetStub().PutState(carID, bytes)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not add the updated car details %s", err)
+	}
+
+	err = claimPlate(ctx, car)
+	if err != nil {
+		return "", err
+	}
+
+	err = emitCarEvent(ctx, EventCarReplated, carID, car)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Car %v now has the registration number %v", carID, registrationNumber), nil
+}
+
+// DeregisterCar withdraws the registration of a car and frees its registration number. The car
+// goes back to Sold and can be registered again.
+func (c *CarContract) DeregisterCar(ctx contractapi.TransactionContextInterface, carID string) (string, error) {
+	err := checkAccess(ctx, "CarContract:DeregisterCar")
+	if err != nil {
+		return "", err
+	}
+
+	car, err := c.readActiveCar(ctx, carID)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not read the data. %s", err)
+	}
+
+	err = requireCarStatus(car, "deregistered", StatusRegistered)
+	if err != nil {
+		return "", err
+	}
+	err = transitionCar(car, StatusSold)
+	if err != nil {
+		return "", err
+	}
+
+	err = releasePlate(ctx, car)
+	if err != nil {
+		return "", err
+	}
+	err = releaseOwner(ctx, car)
+	if err != nil {
+		return "", err
+	}
+	car.RegisteredOwner = ""
+
+	car.Version++
+	bytes, err := marshalCar(ctx, car)
+	if err != nil {
+		return "", err
+	}
+	err = ctx.GetStub().PutState(carID, bytes)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not add the updated car details %s", err)
+	}
+
+	err = applyEndorsementTemplate(ctx, car)
+	if err != nil {
+		return "", err
+	}
+
+	err = emitCarEvent(ctx, EventCarDeregistered, carID, car)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Car %v is deregistered", carID), nil
+}
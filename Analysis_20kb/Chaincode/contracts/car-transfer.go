@@ -0,0 +1,288 @@
+package contracts
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+const transferKeyPrefix string = "transfer"
+
+// TransferProposal is an ownership transfer waiting for the recipient to accept it
+type TransferProposal struct {
+	AssetType  string    `json:"assetType"`
+	CarId      string    `json:"carId"`
+	From       *Identity `json:"from"`
+	To         *Identity `json:"to"`
+	ProposedAt string    `json:"proposedAt"`
+	ExpiresAt  string    `json:"expiresAt"`
+}
+
+// expired returns true when the proposal can no longer be accepted at the given time
+func (t *TransferProposal) expired(now time.Time) bool {
+	expiresAt, err := time.Parse(time.RFC3339, t.ExpiresAt)
+	return err != nil || !now.Before(expiresAt)
+}
+
+func transferKey(ctx contractapi.TransactionContextInterface, carID string) (string, error) {
+	key, err := ctx.GetStub().CreateCompositeKey(transferKeyPrefix, []string{carID})
+	if err != nil {
+		return "", errorf(CodeInternal, "could not create the transfer key. %s", err)
+	}
+	return key, nil
+}
+
+func readTransfer(ctx contractapi.TransactionContextInterface, carID string) (*TransferProposal, string, error) {
+	key, err := transferKey(ctx, carID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	bytes, err := ctx.GetStub().GetState(key)
+	if err != nil {
+		return nil, "", errorf(CodeInternal, "failed to read from world state: %v", err)
+	}
+	if bytes == nil {
+		return nil, key, nil
+	}
+
+	var transfer TransferProposal
+	err = json.Unmarshal(bytes, &transfer)
+	if err != nil {
+		return nil, "", errorf(CodeInternal, "could not unmarshal world state data to type TransferProposal")
+	}
+	return &transfer, key, nil
+}
+
+// ProposeTransfer lets the current owner offer a car to another identity, valid for the given number of seconds
+func (c *CarContract) ProposeTransfer(ctx contractapi.TransactionContextInterface, carID string, recipientMSPID string, recipientEnrollmentID string, validForSeconds int) (string, error) {
+	err := checkAccess(ctx, "CarContract:ProposeTransfer")
+	if err != nil {
+		return "", err
+	}
+
+	caller, err := clientIdentity(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if validForSeconds <= 0 {
+		return "", errorf(CodeInvalidArgument, "the transfer must be valid for a positive number of seconds")
+	}
+
+	car, err := c.readActiveCar(ctx, carID)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not read the data. %s", err)
+	}
+
+	if car.Owner == nil || *car.Owner != caller {
+		return "", errorf(CodeUnauthorized, "only the owner of car %s can propose a transfer", carID)
+	}
+	if car.Status == StatusScrapped {
+		return "", errorf(CodeConflict, "the car %s cannot be transferred while %s", carID, car.Status)
+	}
+	if car.OwnerCommitment != "" {
+		return "", errorf(CodeConflict, "the car %s belongs to its registered owner and cannot be transferred", carID)
+	}
+
+	recipient := Identity{MSPID: recipientMSPID, EnrollmentID: recipientEnrollmentID}
+	if recipient == caller {
+		return "", errorf(CodeConflict, "the car %s is already owned by %s", carID, recipient)
+	}
+
+	now, err := txTime(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	pending, key, err := readTransfer(ctx, carID)
+	if err != nil {
+		return "", err
+	}
+	if pending != nil && !pending.expired(now) {
+		return "", errorf(CodeConflict, "the car %s already has a pending transfer to %s", carID, pending.To)
+	}
+
+	transfer := TransferProposal{
+		AssetType:  "transfer",
+		CarId:      carID,
+		From:       &caller,
+		To:         &recipient,
+		ProposedAt: now.Format(time.RFC3339),
+		ExpiresAt:  now.Add(time.Duration(validForSeconds) * time.Second).Format(time.RFC3339),
+	}
+
+	bytes, _ := json.Marshal(transfer)
+	err = ctx.GetStub().PutState(key, bytes)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not add the transfer proposal %s", err)
+	}
+	return fmt.Sprintf("Transfer of car %v to %v proposed, expires at %v", carID, recipient, transfer.ExpiresAt), nil
+}
+
+// AcceptTransfer lets the recipient of a pending transfer take ownership of the car
+func (c *CarContract) AcceptTransfer(ctx contractapi.TransactionContextInterface, carID string) (string, error) {
+	err := checkAccess(ctx, "CarContract:AcceptTransfer")
+	if err != nil {
+		return "", err
+	}
+
+	caller, err := clientIdentity(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	transfer, key, err := readTransfer(ctx, carID)
+	if err != nil {
+		return "", err
+	}
+	if transfer == nil {
+		return "", errorf(CodeNotFound, "there is no pending transfer for car %s", carID)
+	}
+	if *transfer.To != caller {
+		return "", errorf(CodeUnauthorized, "only %s can accept the transfer of car %s", transfer.To, carID)
+	}
+
+	now, err := txTime(ctx)
+	if err != nil {
+		return "", err
+	}
+	if transfer.expired(now) {
+		return "", errorf(CodeConflict, "the transfer of car %s expired at %s", carID, transfer.ExpiresAt)
+	}
+
+	car, err := c.readActiveCar(ctx, carID)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not read the data. %s", err)
+	}
+	if car.Owner == nil || *car.Owner != *transfer.From {
+		return "", errorf(CodeConflict, "the car %s is no longer owned by %s", carID, transfer.From)
+	}
+	if car.Status == StatusScrapped {
+		return "", errorf(CodeConflict, "the car %s cannot be transferred while %s", carID, car.Status)
+	}
+	// The car may have been sold since the proposal; its owner then lives in the owner collection
+	if car.OwnerCommitment != "" {
+		return "", errorf(CodeConflict, "the car %s belongs to its registered owner and cannot be transferred", carID)
+	}
+
+	car.Owner = transfer.To
+	car.OwnedBy = transfer.To.EnrollmentID
+
+	err = validateCar(car, "ownedBy")
+	if err != nil {
+		return "", err
+	}
+
+	car.Version++
+	bytes, err := marshalCar(ctx, car)
+	if err != nil {
+		return "", err
+	}
+	err = ctx.GetStub().PutState(carID, bytes)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not add the updated car details %s", err)
+	}
+
+	err = ctx.GetStub().DelState(key)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not remove the transfer proposal %s", err)
+	}
+
+	err = emitCarEvent(ctx, EventCarUpdated, carID, car)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Car %v transferred from %v to %v", carID, transfer.From, transfer.To), nil
+}
+
+// CancelTransfer withdraws a pending transfer; either party may cancel it
+func (c *CarContract) CancelTransfer(ctx contractapi.TransactionContextInterface, carID string) (string, error) {
+	err := checkAccess(ctx, "CarContract:CancelTransfer")
+	if err != nil {
+		return "", err
+	}
+
+	caller, err := clientIdentity(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	transfer, key, err := readTransfer(ctx, carID)
+	if err != nil {
+		return "", err
+	}
+	if transfer == nil {
+		return "", errorf(CodeNotFound, "there is no pending transfer for car %s", carID)
+	}
+	if *transfer.From != caller && *transfer.To != caller {
+		return "", errorf(CodeUnauthorized, "only %s or %s can cancel the transfer of car %s", transfer.From, transfer.To, carID)
+	}
+
+	err = ctx.GetStub().DelState(key)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not remove the transfer proposal %s", err)
+	}
+	return fmt.Sprintf("Transfer of car %v cancelled", carID), nil
+}
+
+// GetPendingTransfer returns the unexpired transfer proposal for a car
+func (c *CarContract) GetPendingTransfer(ctx contractapi.TransactionContextInterface, carID string) (*TransferProposal, error) {
+	err := checkAccess(ctx, "CarContract:GetPendingTransfer")
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, _, err := readTransfer(ctx, carID)
+	if err != nil {
+		return nil, err
+	}
+
+	now, err := txTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if transfer == nil || transfer.expired(now) {
+		return nil, errorf(CodeNotFound, "there is no pending transfer for car %s", carID)
+	}
+	return transfer, nil
+}
+
+// GetPendingTransfers returns all unexpired transfer proposals
+func (c *CarContract) GetPendingTransfers(ctx contractapi.TransactionContextInterface) ([]*TransferProposal, error) {
+	err := checkAccess(ctx, "CarContract:GetPendingTransfers")
+	if err != nil {
+		return nil, err
+	}
+
+	resultsIterator, err := ctx.GetStub().GetStateByPartialCompositeKey(transferKeyPrefix, []string{})
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not fetch the transfers. %s", err)
+	}
+	defer resultsIterator.Close()
+
+	now, err := txTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var transfers []*TransferProposal
+	for resultsIterator.HasNext() {
+		queryResult, err := resultsIterator.Next()
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not fetch the details of the result iterator. %s", err)
+		}
+		var transfer TransferProposal
+		err = json.Unmarshal(queryResult.Value, &transfer)
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not unmarshal the data. %s", err)
+		}
+		if !transfer.expired(now) {
+			transfers = append(transfers, &transfer)
+		}
+	}
+
+	return transfers, nil
+}
@@ -0,0 +1,43 @@
+package contracts
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ErrorCode classifies a contract error so clients can handle it without matching the message
+type ErrorCode string
+
+const (
+	CodeNotFound        ErrorCode = "NOT_FOUND"
+	CodeAlreadyExists   ErrorCode = "ALREADY_EXISTS"
+	CodeUnauthorized    ErrorCode = "UNAUTHORIZED"
+	CodeInvalidArgument ErrorCode = "INVALID_ARGUMENT"
+	CodeConflict        ErrorCode = "CONFLICT"
+	CodeInternal        ErrorCode = "INTERNAL"
+)
+
+// ContractError is the error returned by the transactions. Its message is the JSON form
+// {"code":"NOT_FOUND","message":"the car car1 does not exist"}, which reaches clients as the
+// message of the endorsement error.
+type ContractError struct {
+	Code    ErrorCode `json:"code"`
+	Message string    `json:"message"`
+}
+
+func (e *ContractError) Error() string {
+	bytes, _ := json.Marshal(e)
+	return string(bytes)
+}
+
+// errorf formats a ContractError. A ContractError among the arguments is formatted by its message
+// and passes its code on, so wrapping an error keeps the code of its cause.
+func errorf(code ErrorCode, format string, args ...interface{}) error {
+	for i, arg := range args {
+		if cause, ok := arg.(*ContractError); ok {
+			code = cause.Code
+			args[i] = cause.Message
+		}
+	}
+	return &ContractError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
@@ -3,8 +3,10 @@ package contracts
 // first write collection file
 
 import (
-	"encoding/json"
 	"fmt"
+	"time"
+
+	"kbaauto/query"
 
 	"github.com/hyperledger/fabric-chaincode-go/shim"
 	"github.com/hyperledger/fabric-contract-api-go/contractapi"
@@ -15,160 +17,212 @@ type OrderContract struct {
 	contractapi.Contract
 }
 
+// Order is a dealer's request for Quantity cars. The order stays open until that many cars are
+// matched to it; CarIds lists them and CarId is the last one.
 type Order struct {
-	AssetType  string `json:"assetType"`
-	Color      string `json:"color"`
-	DealerName string `json:"dealerName"`
-	Make       string `json:"make"`
-	Model      string `json:"model"`
-	OrderID    string `json:"orderID"`
+	AssetType    string      `json:"assetType"`
+	Color        string      `json:"color" validate:"required,max=32,charset=name"`
+	DealerName   string      `json:"dealerName" validate:"required,max=128,charset=name"`
+	Make         string      `json:"make" validate:"required,max=64,charset=name"`
+	Model        string      `json:"model" validate:"required,max=64,charset=name"`
+	OrderID      string      `json:"orderID" validate:"required,max=64,charset=id"`
+	Quantity     int         `json:"quantity,omitempty" metadata:",optional"`
+	Options      []string    `json:"options,omitempty" validate:"required,max=64,charset=name" metadata:",optional"`
+	DeliveryDate string      `json:"deliveryDate,omitempty" validate:"date" metadata:",optional"`
+	Notes        string      `json:"notes,omitempty" validate:"max=512" metadata:",optional"`
+	Status       OrderStatus `json:"status" validate:"oneof=Open|Matched|Cancelled|Expired|Fulfilled"`
+	CarId        string      `json:"carId,omitempty" metadata:",optional"`
+	CarIds       []string    `json:"carIds,omitempty" metadata:",optional"`
+	CreatedBy    *Identity   `json:"createdBy,omitempty" metadata:",optional"`
+	CreatedAt    string      `json:"createdAt,omitempty" metadata:",optional"`
+	UpdatedBy    *Identity   `json:"updatedBy,omitempty" metadata:",optional"`
+	UpdatedAt    string      `json:"updatedAt,omitempty" metadata:",optional"`
+	ExpiresAt    string      `json:"expiresAt,omitempty" metadata:",optional"`
 }
 
 const collectionName string = "OrderCollection"
 
 // OrderExists returns true when asset with given ID exists in private data collection
 func (o *OrderContract) OrderExists(ctx contractapi.TransactionContextInterface, orderID string) (bool, error) {
+	err := checkAccess(ctx, "OrderContract:OrderExists")
+	if err != nil {
+		return false, err
+	}
+
+	return o.orderExists(ctx, orderID)
+}
+
+func (o *OrderContract) orderExists(ctx contractapi.TransactionContextInterface, orderID string) (bool, error) {
 
 	data, err := ctx.GetStub().GetPrivateDataHash(collectionName, orderID)
 
 	if err != nil {
-		return false, fmt.Errorf("could not fetch the private data hash. %s", err)
+		return false, errorf(CodeInternal, "could not fetch the private data hash. %s", err)
 	}
 
 	return data != nil, nil
 }
 
-// CreateOrder creates a new instance of Order
+// CreateOrder creates a new instance of Order from the transient map: either a JSON order request
+// under the "order" key, or the make, model, color and dealerName keys
 func (o *OrderContract) CreateOrder(ctx contractapi.TransactionContextInterface, orderID string) (string, error) {
 
-	clientOrgID, err := ctx.GetClientIdentity().GetMSPID()
+	err := checkAccess(ctx, "OrderContract:CreateOrder")
 	if err != nil {
-		return "", fmt.Errorf("could not fetch client identity. %s", err)
+		return "", err
 	}
 
-	if clientOrgID == "DealerMSP" {
-	// if clientOrgID == "Org2MSP" {
-		//if clientOrgID == "dealer-auto-com" {
-		exists, err := o.OrderExists(ctx, orderID)
-		if err != nil {
-			return "", fmt.Errorf("could not read from world state. %s", err)
-		} else if exists {
-			return "", fmt.Errorf("the asset %s already exists", orderID)
-		}
+	exists, err := o.orderExists(ctx, orderID)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not read from world state. %s", err)
+	} else if exists {
+		return "", errorf(CodeAlreadyExists, "the asset %s already exists", orderID)
+	}
 
-		var order Order
+	var order Order
 
-		transientData, err := ctx.GetStub().GetTransient()
-		if err != nil {
-			return "", fmt.Errorf("could not fetch transient data. %s", err)
-		}
+	transientData, err := ctx.GetStub().GetTransient()
+	if err != nil {
+		return "", errorf(CodeInternal, "could not fetch transient data. %s", err)
+	}
 
-		if len(transientData) == 0 {
-			return "", fmt.Errorf("please provide the private data of make, model, color, dealerName")
-		}
+	if len(transientData) == 0 {
+		return "", errorf(CodeInvalidArgument, "please provide the order, or the private data of make, model, color, dealerName")
+	}
 
+	if request, exists := transientData[transientOrderKey]; exists {
+		err = parseOrderRequest(request, &order)
+		if err != nil {
+			return "", err
+		}
+	} else {
 		make, exists := transientData["make"]
 		if !exists {
-			return "", fmt.Errorf("the make was not specified in transient data. Please try again")
+			return "", errorf(CodeInvalidArgument, "the make was not specified in transient data. Please try again")
 		}
 		order.Make = string(make)
 
 		model, exists := transientData["model"]
 		if !exists {
-			return "", fmt.Errorf("the model was not specified in transient data. Please try again")
+			return "", errorf(CodeInvalidArgument, "the model was not specified in transient data. Please try again")
 		}
 		order.Model = string(model)
 
 		color, exists := transientData["color"]
 		if !exists {
-			return "", fmt.Errorf("the color was not specified in transient data. Please try again")
+			return "", errorf(CodeInvalidArgument, "the color was not specified in transient data. Please try again")
 		}
 		order.Color = string(color)
 
 		dealerName, exists := transientData["dealerName"]
 		if !exists {
-			return "", fmt.Errorf("the dealer was not specified in transient data. Please try again")
+			return "", errorf(CodeInvalidArgument, "the dealer was not specified in transient data. Please try again")
 		}
 		order.DealerName = string(dealerName)
+		order.Quantity = 1
+	}
 
-		order.AssetType = "Order"
-		order.OrderID = orderID
+	creator, err := clientIdentity(ctx)
+	if err != nil {
+		return "", err
+	}
 
-		bytes, _ := json.Marshal(order)
-		err = ctx.GetStub().PutPrivateData(collectionName, orderID, bytes)
-		if err != nil {
-			return "", fmt.Errorf("could not able to write the data")
-		}
-		return fmt.Sprintf("order with id %v added successfully", orderID), nil
-	} else {
-		return fmt.Sprintf("order cannot be created by organisation with MSPID %v ", clientOrgID), nil
+	now, err := txTime(ctx)
+	if err != nil {
+		return "", err
 	}
-}
 
-// ReadOrder retrieves an instance of Order from the private data collection
-func (o *OrderContract) ReadOrder(ctx contractapi.TransactionContextInterface, orderID string) (*Order, error) {
-	exists, err := o.OrderExists(ctx, orderID)
+	order.AssetType = "Order"
+	order.OrderID = orderID
+	order.Status = OrderOpen
+	order.CreatedBy = &creator
+	order.CreatedAt = now.Format(time.RFC3339)
+	order.UpdatedBy = &creator
+	order.UpdatedAt = order.CreatedAt
+	order.ExpiresAt = now.Add(orderValidity).Format(time.RFC3339)
+
+	err = validateOrder(&order)
 	if err != nil {
-		return nil, fmt.Errorf("could not read from world state. %s", err)
-	} else if !exists {
-		return nil, fmt.Errorf("the asset %s does not exist", orderID)
+		return "", err
 	}
 
-	bytes, err := ctx.GetStub().GetPrivateData(collectionName, orderID)
+	bytes, err := marshalOrder(ctx, &order)
 	if err != nil {
-		return nil, fmt.Errorf("could not get the private data. %s", err)
+		return "", err
+	}
+	err = ctx.GetStub().PutPrivateData(collectionName, orderID, bytes)
+	if err != nil {
+		return "", errorf(CodeInternal, "could not able to write the data")
 	}
-	var order Order
 
-	err = json.Unmarshal(bytes, &order)
+	err = indexOrder(ctx, &order)
+	if err != nil {
+		return "", err
+	}
 
+	err = emitOrderEvent(ctx, EventOrderCreated, orderID, "")
 	if err != nil {
-		return nil, fmt.Errorf("could not unmarshal private data collection data to type Order")
+		return "", err
 	}
+	return fmt.Sprintf("order with id %v added successfully", orderID), nil
+}
 
-	return &order, nil
+// ReadOrder retrieves an instance of Order from the private data collection
+func (o *OrderContract) ReadOrder(ctx contractapi.TransactionContextInterface, orderID string) (*Order, error) {
+	err := checkAccess(ctx, "OrderContract:ReadOrder")
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := o.orderExists(ctx, orderID)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not read from world state. %s", err)
+	} else if !exists {
+		return nil, errorf(CodeNotFound, "the asset %s does not exist", orderID)
+	}
 
+	return readOrder(ctx, orderID)
 }
 
-// DeleteOrder deletes an instance of Order from the private data collection
+// DeleteOrder cancels an order. Orders are kept in the private data collection as the dealer's order history.
 func (o *OrderContract) DeleteOrder(ctx contractapi.TransactionContextInterface, orderID string) error {
-	clientOrgID, err := ctx.GetClientIdentity().GetMSPID()
+	err := checkAccess(ctx, "OrderContract:DeleteOrder")
 	if err != nil {
-		return fmt.Errorf("could not read the client identity. %s", err)
+		return err
 	}
-	if clientOrgID == "DealerMSP" {
-	// if clientOrgID == "Org2MSP" {
-		//if clientOrgID == "dealer-auto-com" {
 
-		exists, err := o.OrderExists(ctx, orderID)
+	return o.cancelOrder(ctx, orderID)
+}
 
-		if err != nil {
-			return fmt.Errorf("could not read from world state. %s", err)
-		} else if !exists {
-			return fmt.Errorf("the asset %s does not exist", orderID)
-		}
+func (o *OrderContract) GetAllOrders(ctx contractapi.TransactionContextInterface) ([]*Order, error) {
+	err := checkAccess(ctx, "OrderContract:GetAllOrders")
+	if err != nil {
+		return nil, err
+	}
 
-		return ctx.GetStub().DelPrivateData(collectionName, orderID)
-	} else {
-		return fmt.Errorf("organisation with %v cannot delete the order", clientOrgID)
+	queryString, err := query.New().Eq("assetType", "Order").String()
+	if err != nil {
+		return nil, errorf(CodeInternal, "%s", err)
 	}
-}
 
-func (o *OrderContract) GetAllOrders(ctx contractapi.TransactionContextInterface) ([]*Order, error) {
-	queryString := `{"selector":{"assetType":"Order"}}`
 	resultsIterator, err := ctx.GetStub().GetPrivateDataQueryResult(collectionName, queryString)
 	if err != nil {
-		return nil, fmt.Errorf("could not fetch the query result. %s", err)
+		return nil, errorf(CodeInternal, "could not fetch the query result. %s", err)
 	}
 	defer resultsIterator.Close()
 	return OrderResultIteratorFunction(resultsIterator)
 }
 
 func (o *OrderContract) GetOrdersByRange(ctx contractapi.TransactionContextInterface, startKey string, endKey string) ([]*Order, error) {
+	err := checkAccess(ctx, "OrderContract:GetOrdersByRange")
+	if err != nil {
+		return nil, err
+	}
+
 	resultsIterator, err := ctx.GetStub().GetPrivateDataByRange(collectionName, startKey, endKey)
 
 	if err != nil {
-		return nil, fmt.Errorf("could not fetch the private data by range. %s", err)
+		return nil, errorf(CodeInternal, "could not fetch the private data by range. %s", err)
 	}
 	defer resultsIterator.Close()
 
@@ -183,12 +237,15 @@ func OrderResultIteratorFunction(resultsIterator shim.StateQueryIteratorInterfac
 	for resultsIterator.HasNext() {
 		queryResult, err := resultsIterator.Next()
 		if err != nil {
-			return nil, fmt.Errorf("could not fetch the details of result iterator. %s", err)
+			return nil, errorf(CodeInternal, "could not fetch the details of result iterator. %s", err)
 		}
 		var order Order
-		err = json.Unmarshal(queryResult.Value, &order)
+		err = unmarshalOrder(queryResult.Value, &order)
 		if err != nil {
-			return nil, fmt.Errorf("could not unmarshal the data. %s", err)
+			return nil, errorf(CodeInternal, "could not unmarshal the data. %s", err)
+		}
+		if order.Status == "" {
+			order.Status = OrderOpen
 		}
 		orders = append(orders, &order)
 	}
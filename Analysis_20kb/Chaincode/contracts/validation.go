@@ -0,0 +1,40 @@
+package contracts
+
+import (
+	"errors"
+	"fmt"
+
+	"kbaauto/validate"
+)
+
+// validateCar checks the car against the rules in the Car validate tags, limited to the given
+// JSON fields when there are any, so a transaction only answers for the fields it writes
+func validateCar(car *Car, fields ...string) error {
+	err := validate.Struct(car, fields...)
+	if err != nil {
+		return errorf(CodeInvalidArgument, "invalid car. %s", err)
+	}
+	return nil
+}
+
+// validateOrder checks the order against the rules in the Order validate tags and the limits
+// on its quantity and options
+func validateOrder(order *Order) error {
+	err := validate.Struct(order)
+
+	var errs validate.Errors
+	if err != nil && !errors.As(err, &errs) {
+		return errorf(CodeInternal, "invalid order. %s", err)
+	}
+	if order.Quantity < 1 || order.Quantity > maxOrderQuantity {
+		errs = append(errs, validate.FieldError{Field: "quantity", Message: fmt.Sprintf("must be between 1 and %d", maxOrderQuantity)})
+	}
+	if len(order.Options) > maxOrderOptions {
+		errs = append(errs, validate.FieldError{Field: "options", Message: fmt.Sprintf("must have at most %d entries", maxOrderOptions)})
+	}
+
+	if len(errs) > 0 {
+		return errorf(CodeInvalidArgument, "invalid order. %s", errs)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package chaincodetest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"kbaauto/contracts"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultAccessPolicy(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("DealerMSP")
+	worldState := prepWorldState(chaincodeStub)
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	bytes, err := json.Marshal(&contracts.Car{CarId: "car1", Status: contracts.StatusManufactured})
+	require.NoError(t, err)
+	worldState["car1"] = bytes
+
+	// Assert a dealer cannot delete a car
+	_, err = carAsset.DeleteCar(transactionContext, "car1")
+	requireError(t, err, contracts.CodeUnauthorized, "user under following MSPID: DealerMSP can't perform this action")
+
+	// Assert the manufacturer MSP of every network can
+	for _, mspID := range []string{"ManufacturerMSP", "Org1MSP", "manufacturer-auto-com"} {
+		worldState["car1"] = bytes
+		setClient(transactionContext, mspID, "user1")
+		_, err = carAsset.DeleteCar(transactionContext, "car1")
+		require.NoError(t, err)
+	}
+}
+
+func TestSetAccessRule(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("DealerMSP")
+	prepWorldState(chaincodeStub)
+
+	// Create contract instances
+	accessContract := contracts.AccessContract{}
+	carAsset := contracts.CarContract{}
+
+	rule := contracts.AccessRule{
+		MSPIDs:     []string{"ManufacturerMSP"},
+		Attributes: map[string]string{"role": "admin"},
+	}
+
+	// Assert only an admin can change the policy
+	_, err := accessContract.SetAccessRule(transactionContext, "CarContract:CreateCar", rule)
+	requireError(t, err, contracts.CodeUnauthorized, "user under following MSPID: DealerMSP can't perform this action")
+
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+	_, err = accessContract.SetAccessRule(transactionContext, "CarContract:CreateCar", rule)
+	requireError(t, err, contracts.CodeUnauthorized, "unauthorized user: must have 'role=admin'")
+
+	_, err = accessContract.RemoveAccessRule(transactionContext, "CarContract:CreateCar")
+	requireError(t, err, contracts.CodeUnauthorized, "unauthorized user: must have 'role=admin'")
+
+	setAdmin(transactionContext, "user1")
+	_, err = accessContract.SetAccessRule(transactionContext, "CarContract:Unknown", rule)
+	requireError(t, err, contracts.CodeInvalidArgument, "the function CarContract:Unknown is not known")
+
+	_, err = accessContract.SetAccessRule(transactionContext, "CarContract:CreateCar", rule)
+	require.NoError(t, err)
+
+	policy, err := accessContract.GetAccessPolicy(transactionContext)
+	require.NoError(t, err)
+	require.Equal(t, rule, policy.Rules["CarContract:CreateCar"])
+
+	// Assert the stored rule now requires the role attribute
+	setClient(transactionContext, "ManufacturerMSP", "user2")
+	_, err = carAsset.CreateCar(transactionContext, "car1", "Honda", "Civic", "Blue", "Factory-01", "2024-01-01")
+	requireError(t, err, contracts.CodeUnauthorized, "unauthorized user: must have 'role=admin'")
+
+	// Assert removing the rule restores the default
+	setAdmin(transactionContext, "user1")
+	_, err = accessContract.RemoveAccessRule(transactionContext, "CarContract:CreateCar")
+	require.NoError(t, err)
+
+	setClient(transactionContext, "ManufacturerMSP", "user2")
+
+	_, err = carAsset.CreateCar(transactionContext, "car1", "Honda", "Civic", "Blue", "Factory-01", "2024-01-01")
+	require.NoError(t, err)
+}
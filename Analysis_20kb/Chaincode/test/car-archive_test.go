@@ -0,0 +1,114 @@
+package chaincodetest
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"kbaauto/contracts"
+
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveCar(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("ManufacturerMSP")
+	worldState := prepWorldState(chaincodeStub)
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	bytes, err := json.Marshal(&contracts.Car{CarId: "car1", Status: contracts.StatusScrapped, Version: 1})
+	require.NoError(t, err)
+	worldState["car1"] = bytes
+
+	// Assert archiving keeps the car readable with the reason and actor
+	_, err = carAsset.ArchiveCar(transactionContext, "car1", "")
+	requireError(t, err, contracts.CodeInvalidArgument, "a reason is required to archive the car car1")
+
+	_, err = carAsset.ArchiveCar(transactionContext, "car1", "written off")
+	require.NoError(t, err)
+
+	car, err := carAsset.ReadCar(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Equal(t, "written off", car.Archive.Reason)
+	require.Equal(t, "ManufacturerMSP/user1", car.Archive.ArchivedBy.String())
+	require.Equal(t, int64(2), car.Version)
+
+	_, err = carAsset.ArchiveCar(transactionContext, "car1", "written off")
+	requireError(t, err, contracts.CodeConflict, "the car car1 is already archived")
+
+	// Assert an archived car cannot be changed
+	_, err = carAsset.PatchCar(transactionContext, "car1", `{"color":"Blue"}`, 0)
+	requireError(t, err, contracts.CodeConflict, "the car car1 is archived and cannot be changed")
+
+	_, err = carAsset.AttachDocument(transactionContext, "car1", "invoice.pdf", strings.Repeat("ab", 32), "application/pdf", 100)
+	requireError(t, err, contracts.CodeConflict, "the car car1 is archived and cannot be changed")
+
+	_, err = carAsset.PutCarPayload(transactionContext, "car1", "brochure", "payload", false)
+	requireError(t, err, contracts.CodeConflict, "the car car1 is archived and cannot be changed")
+
+	_, err = carAsset.ProposeTransfer(transactionContext, "car1", "DealerMSP", "dealer1", 3600)
+	requireError(t, err, contracts.CodeConflict, "could not read the data. the car car1 is archived and cannot be changed")
+
+	// Assert purging needs the admin role
+	_, err = carAsset.PurgeCar(transactionContext, "car1")
+	requireError(t, err, contracts.CodeUnauthorized, "unauthorized user: must have 'role=admin'")
+
+	// Assert restoring clears the archive record
+	_, err = carAsset.RestoreCar(transactionContext, "car1")
+	require.NoError(t, err)
+
+	car, err = carAsset.ReadCar(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Nil(t, car.Archive)
+
+	_, err = carAsset.RestoreCar(transactionContext, "car1")
+	requireError(t, err, contracts.CodeConflict, "the car car1 is not archived")
+}
+
+func TestPurgeCar(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("ManufacturerMSP")
+	worldState := prepWorldState(chaincodeStub)
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+	setAdmin(transactionContext, "user1")
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	chaincodeStub.GetStateByPartialCompositeKeyCalls(func(objectType string, attributes []string) (shim.StateQueryIteratorInterface, error) {
+		return prefixIterator(worldState, "\x00"+objectType+"\x00"+strings.Join(attributes, "\x00")+"\x00"), nil
+	})
+
+	owner := &contracts.Identity{MSPID: "ManufacturerMSP", EnrollmentID: "user1"}
+	bytes, err := json.Marshal(&contracts.Car{CarId: "car1", Status: contracts.StatusManufactured, Owner: owner})
+	require.NoError(t, err)
+	worldState["car1"] = bytes
+
+	// The car has a chunked payload, a pending transfer and a recall remedy
+	_, err = carAsset.PutCarPayload(transactionContext, "car1", "brochure", strings.Repeat("x", 10*1024), true)
+	require.NoError(t, err)
+	_, err = carAsset.ProposeTransfer(transactionContext, "car1", "DealerMSP", "dealer1", 3600)
+	require.NoError(t, err)
+	worldState["\x00carrecall\x00car1\x00recall1\x00"] = []byte(`{"status":"Open"}`)
+	worldState["\x00carrecall\x00car10\x00recall1\x00"] = []byte(`{"status":"Open"}`)
+	require.Len(t, worldState, 7)
+
+	// Assert only archived cars can be purged
+	_, err = carAsset.PurgeCar(transactionContext, "car1")
+	requireError(t, err, contracts.CodeConflict, "the car car1 must be archived before it is purged")
+
+	_, err = carAsset.DeleteCar(transactionContext, "car1")
+	require.NoError(t, err)
+
+	_, err = carAsset.PurgeCar(transactionContext, "car1")
+	require.NoError(t, err)
+	require.NotContains(t, worldState, "car1")
+
+	// Assert the records kept beside the car are purged with it, and only those
+	require.Len(t, worldState, 1)
+	require.Contains(t, worldState, "\x00carrecall\x00car10\x00recall1\x00")
+}
@@ -0,0 +1,184 @@
+package chaincodetest
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"kbaauto/contracts"
+	"kbaauto/test/mocks"
+
+	"github.com/stretchr/testify/require"
+)
+
+// setOwner passes the owner of a car in the transient map
+func setOwner(chaincodeStub *mocks.ChaincodeStub, name string, salt string) {
+	owner, _ := json.Marshal(map[string]string{"name": name, "salt": salt})
+	chaincodeStub.GetTransientReturns(map[string][]byte{"owner": owner}, nil)
+}
+
+func TestCarOwner(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("MvdMSP")
+	worldState := prepWorldState(chaincodeStub)
+	privateData := prepPrivateData(chaincodeStub)
+	setClient(transactionContext, "MvdMSP", "user1")
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	bytes, err := json.Marshal(&contracts.Car{CarId: "car1", Status: contracts.StatusSold, OwnedBy: "KBA3"})
+	require.NoError(t, err)
+	worldState["car1"] = bytes
+
+	// Assert the owner name stays out of the world state
+	setOwner(chaincodeStub, "Jane", "short")
+	_, err = carAsset.RegisterCar(transactionContext, "car1", "KL-01")
+	requireError(t, err, contracts.CodeInvalidArgument, "invalid owner. salt must have at least 16 characters")
+
+	setOwner(chaincodeStub, "Jane Doe", "0123456789abcdef")
+	result, err := carAsset.RegisterCar(transactionContext, "car1", "KL-01")
+	require.NoError(t, err)
+	require.Equal(t, "Car car1 successfully registered as KL-01", result)
+	for key, value := range worldState {
+		require.NotContains(t, string(value), "Jane", key)
+	}
+
+	collection, key, _ := chaincodeStub.PutPrivateDataArgsForCall(0)
+	require.Equal(t, "OwnerCollection", collection)
+	require.Equal(t, "car1", key)
+
+	owner, err := carAsset.GetCarOwner(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Equal(t, "Jane Doe", owner.Name)
+
+	car, err := carAsset.ReadCar(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Equal(t, owner.Commitment, car.OwnerCommitment)
+
+	// Assert the owner proves ownership with the name and salt, anyone else fails
+	setClient(transactionContext, "DealerMSP", "dealer1")
+	proof, err := carAsset.ProveOwnership(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Equal(t, &contracts.OwnershipProof{CarId: "car1", Verified: true}, proof)
+
+	setOwner(chaincodeStub, "Jane Doe", "0123456789abcdeF")
+	proof, err = carAsset.ProveOwnership(transactionContext, "car1")
+	require.NoError(t, err)
+	require.False(t, proof.Verified)
+
+	setOwner(chaincodeStub, strings.Repeat("x", 129), "0123456789abcdef")
+	_, err = carAsset.ProveOwnership(transactionContext, "car1")
+	requireError(t, err, contracts.CodeInvalidArgument, "invalid owner. name must have at most 128 characters")
+
+	chaincodeStub.GetTransientReturns(map[string][]byte{"owner": []byte(`{"name":"Jane Doe","salt":"0123456789abcdef","dob":"1990-01-01"}`)}, nil)
+	_, err = carAsset.ProveOwnership(transactionContext, "car1")
+	requireError(t, err, contracts.CodeInvalidArgument, `could not parse the owner. json: unknown field "dob"`)
+
+	// Assert deregistration purges the owner
+	setClient(transactionContext, "MvdMSP", "user1")
+	_, err = carAsset.DeregisterCar(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Empty(t, privateData)
+
+	collection, key = chaincodeStub.PurgePrivateDataArgsForCall(0)
+	require.Equal(t, "OwnerCollection", collection)
+	require.Equal(t, "car1", key)
+
+	_, err = carAsset.GetCarOwner(transactionContext, "car1")
+	requireError(t, err, contracts.CodeNotFound, "the car car1 has no registered owner")
+
+	setOwner(chaincodeStub, "Jane Doe", "0123456789abcdef")
+	_, err = carAsset.ProveOwnership(transactionContext, "car1")
+	requireError(t, err, contracts.CodeConflict, "the car car1 has no registered owner")
+}
+
+func TestSellCarOwner(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("DealerMSP")
+	worldState := prepWorldState(chaincodeStub)
+	prepPrivateData(chaincodeStub)
+	setClient(transactionContext, "DealerMSP", "dealer1")
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	bytes, err := json.Marshal(&contracts.Car{CarId: "car1", Status: contracts.StatusAtDealer, OwnedBy: "KBA3"})
+	require.NoError(t, err)
+	worldState["car1"] = bytes
+
+	chaincodeStub.GetTransientReturns(map[string][]byte{}, nil)
+	_, err = carAsset.SellCar(transactionContext, "car1")
+	requireError(t, err, contracts.CodeInvalidArgument, "the owner was not specified in transient data. Please try again")
+
+	// Assert the buyer name stays out of the world state
+	setOwner(chaincodeStub, "Jane Doe", "0123456789abcdef")
+	result, err := carAsset.SellCar(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Equal(t, "Car car1 sold", result)
+	require.NotContains(t, string(worldState["car1"]), "Jane")
+
+	collection, key, _ := chaincodeStub.PutPrivateDataArgsForCall(0)
+	require.Equal(t, "OwnerCollection", collection)
+	require.Equal(t, "car1", key)
+
+	car, err := carAsset.ReadCar(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Equal(t, contracts.StatusSold, car.Status)
+	require.Len(t, car.OwnerCommitment, 64)
+	require.Equal(t, car.OwnerCommitment, car.OwnedBy)
+
+	proof, err := carAsset.ProveOwnership(transactionContext, "car1")
+	require.NoError(t, err)
+	require.True(t, proof.Verified)
+}
+
+func TestMigrateCarOwner(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("MvdMSP")
+	worldState := prepWorldState(chaincodeStub)
+	privateData := prepPrivateData(chaincodeStub)
+	setClient(transactionContext, "MvdMSP", "user1")
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	// A car registered before owners were kept private
+	worldState["car1"] = []byte(`{"carId":"car1","ownedBy":"Jane Doe","status":"Registered to Jane Doe with plate number KL-01","registeredOwner":"Jane Doe"}`)
+
+	setOwner(chaincodeStub, "John Doe", "0123456789abcdef")
+	_, err := carAsset.MigrateCarOwner(transactionContext, "car1")
+	requireError(t, err, contracts.CodeInvalidArgument, "the owner does not match the registered owner of car car1")
+
+	// Assert the name moves to the owner collection and only its commitment stays on the car
+	setOwner(chaincodeStub, "Jane Doe", "0123456789abcdef")
+	result, err := carAsset.MigrateCarOwner(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Equal(t, "owner of car car1 moved to the owner collection", result)
+	require.NotContains(t, string(worldState["car1"]), "Jane")
+	require.Contains(t, privateData, "car1")
+
+	proof, err := carAsset.ProveOwnership(transactionContext, "car1")
+	require.NoError(t, err)
+	require.True(t, proof.Verified)
+
+	_, err = carAsset.MigrateCarOwner(transactionContext, "car1")
+	requireError(t, err, contracts.CodeConflict, "the car car1 has no registered owner to migrate")
+
+	// A car registered by the first chaincode version, which kept the owner in ownedBy only
+	worldState["car2"] = []byte(`{"assetType":"car","carId":"car2","color":"White","dateOfManufacture":"2023-03-01","make":"Tata","model":"Nexon","ownedBy":"Jane Doe","status":"Registered to  Jane Doe with plate number KL-02"}`)
+
+	setOwner(chaincodeStub, "John Doe", "0123456789abcdef")
+	_, err = carAsset.MigrateCarOwner(transactionContext, "car2")
+	requireError(t, err, contracts.CodeInvalidArgument, "the owner does not match the registered owner of car car2")
+
+	setOwner(chaincodeStub, "Jane Doe", "0123456789abcdef")
+	_, err = carAsset.MigrateCarOwner(transactionContext, "car2")
+	require.NoError(t, err)
+	require.NotContains(t, string(worldState["car2"]), "Jane")
+	require.Contains(t, privateData, "car2")
+
+	proof, err = carAsset.ProveOwnership(transactionContext, "car2")
+	require.NoError(t, err)
+	require.True(t, proof.Verified)
+}
@@ -0,0 +1,91 @@
+package chaincodetest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"kbaauto/contracts"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlateRegistry(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("MvdMSP")
+	worldState := prepWorldState(chaincodeStub)
+	setClient(transactionContext, "MvdMSP", "user1")
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	unregistered := map[string][]byte{}
+	for _, carID := range []string{"car1", "car2"} {
+		bytes, err := json.Marshal(&contracts.Car{CarId: carID, Status: contracts.StatusSold})
+		require.NoError(t, err)
+		worldState[carID] = bytes
+		unregistered[carID] = bytes
+	}
+
+	// Assert a registration number needs a letter or digit
+	setOwner(chaincodeStub, "Jane", "0123456789abcdef")
+	_, err := carAsset.RegisterCar(transactionContext, "car1", "")
+	requireError(t, err, contracts.CodeInvalidArgument, "the registration number must have at least one letter or digit")
+	_, err = carAsset.RegisterCar(transactionContext, "car1", "- -")
+	requireError(t, err, contracts.CodeInvalidArgument, "the registration number must have at least one letter or digit")
+
+	_, err = carAsset.RegisterCar(transactionContext, "car1", "KL-01 AB 1")
+	require.NoError(t, err)
+
+	// Assert a registration number differing only in case, spaces or dashes is taken
+	setOwner(chaincodeStub, "John", "fedcba9876543210")
+	_, err = carAsset.RegisterCar(transactionContext, "car2", "kl01ab1")
+	requireError(t, err, contracts.CodeAlreadyExists, "the registration number kl01ab1 is already assigned to the car car1")
+	worldState["car2"] = unregistered["car2"] // a failed transaction writes nothing
+
+	car, err := carAsset.GetCarByPlate(transactionContext, "KL01AB1")
+	require.NoError(t, err)
+	require.Equal(t, "car1", car.CarId)
+	require.Equal(t, "KL-01 AB 1", car.RegistrationNumber)
+
+	// Assert replating frees the old registration number
+	_, err = carAsset.ReplateCar(transactionContext, "car1", "KL-01 AB 1")
+	requireError(t, err, contracts.CodeConflict, "the car car1 already has the registration number KL-01 AB 1")
+
+	_, err = carAsset.ReplateCar(transactionContext, "car1", " - ")
+	requireError(t, err, contracts.CodeInvalidArgument, "the registration number must have at least one letter or digit")
+
+	_, err = carAsset.ReplateCar(transactionContext, "car1", "KL-02")
+	require.NoError(t, err)
+	eventName, _ := chaincodeStub.SetEventArgsForCall(chaincodeStub.SetEventCallCount() - 1)
+	require.Equal(t, contracts.EventCarReplated, eventName)
+
+	_, err = carAsset.RegisterCar(transactionContext, "car2", "KL-01 AB 1")
+	require.NoError(t, err)
+
+	car, err = carAsset.GetCarByPlate(transactionContext, "KL-02")
+	require.NoError(t, err)
+	require.Equal(t, "car1", car.CarId)
+
+	// Assert deregistration returns the car to Sold and frees its registration number
+	_, err = carAsset.DeregisterCar(transactionContext, "car2")
+	require.NoError(t, err)
+
+	car, err = carAsset.ReadCar(transactionContext, "car2")
+	require.NoError(t, err)
+	require.Equal(t, contracts.StatusSold, car.Status)
+	require.Empty(t, car.RegistrationNumber)
+	require.Empty(t, car.RegisteredOwner)
+
+	_, err = carAsset.GetCarByPlate(transactionContext, "KL-01 AB 1")
+	requireError(t, err, contracts.CodeNotFound, "the registration number KL-01 AB 1 is not assigned to any car")
+
+	_, err = carAsset.ReplateCar(transactionContext, "car2", "KL-03")
+	requireError(t, err, contracts.CodeConflict, "the car car2 cannot be replated while Sold")
+
+	// Assert scrapping a car frees its registration number
+	_, err = carAsset.ScrapCar(transactionContext, "car1")
+	require.NoError(t, err)
+
+	_, err = carAsset.GetCarByPlate(transactionContext, "KL-02")
+	requireError(t, err, contracts.CodeNotFound, "the registration number KL-02 is not assigned to any car")
+}
@@ -0,0 +1,178 @@
+package chaincodetest
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"testing"
+
+	"kbaauto/contracts"
+
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// prefixIterator iterates over the keys of an in-memory world state starting with prefix
+func prefixIterator(state map[string][]byte, prefix string) *stateIterator {
+	iterator := &stateIterator{state: state}
+	for key := range state {
+		if strings.HasPrefix(key, prefix) {
+			iterator.keys = append(iterator.keys, key)
+		}
+	}
+	sort.Strings(iterator.keys)
+	return iterator
+}
+
+func TestCarRecall(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("ManufacturerMSP")
+	worldState := prepWorldState(chaincodeStub)
+	prepPrivateData(chaincodeStub)
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000}, nil)
+	chaincodeStub.GetQueryResultCalls(func(query string) (shim.StateQueryIteratorInterface, error) {
+		return newStateIterator(worldState, "", ""), nil
+	})
+	chaincodeStub.GetStateByPartialCompositeKeyCalls(func(objectType string, attributes []string) (shim.StateQueryIteratorInterface, error) {
+		return prefixIterator(worldState, "\x00"+objectType+"\x00"+strings.Join(attributes, "\x00")+"\x00"), nil
+	})
+	chaincodeStub.GetQueryResultWithPaginationCalls(func(query string, pageSize int32, bookmark string) (shim.StateQueryIteratorInterface, *peer.QueryResponseMetadata, error) {
+		iterator := prefixIterator(worldState, "\x00carrecall\x00")
+		return iterator, &peer.QueryResponseMetadata{FetchedRecordsCount: int32(len(iterator.keys))}, nil
+	})
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	cars := []struct{ carID, model, date string }{
+		{"car1", "Nexon", "2023-03-01"},
+		{"car2", "Nexon", "2023-09-01"},
+		{"car3", "Nexon", "2024-02-01"},
+		{"car4", "Punch", "2023-05-01"},
+		{"car5", "Nexon", "2023-06-01"},
+		{"car6", "Nexon", "2023-04-01"},
+	}
+	for _, car := range cars {
+		_, err := carAsset.CreateCar(transactionContext, car.carID, "Tata", car.model, "White", "Factory-01", car.date)
+		require.NoError(t, err)
+	}
+	_, err := carAsset.ScrapCar(transactionContext, "car5")
+	require.NoError(t, err)
+	_, err = carAsset.ArchiveCar(transactionContext, "car6", "Written off")
+	require.NoError(t, err)
+
+	// Assert a recall needs either criteria or a car list
+	_, err = carAsset.IssueRecall(transactionContext, "recall1", "Tata", "Nexon", "", "", `["car4"]`, "Faulty airbag")
+	requireError(t, err, contracts.CodeInvalidArgument, "a recall needs either a make and model, with an optional manufacture date range, or a car list")
+	_, err = carAsset.IssueRecall(transactionContext, "recall1", "Tata", "", "", "", "", "Faulty airbag")
+	requireError(t, err, contracts.CodeInvalidArgument, "a recall needs either a make and model, with an optional manufacture date range, or a car list")
+
+	// Assert only manufacturers issue recalls
+	setClient(transactionContext, "DealerMSP", "dealer1")
+	_, err = carAsset.IssueRecall(transactionContext, "recall1", "Tata", "Nexon", "2023-01-01", "2023-12-31", "", "Faulty airbag")
+	require.Error(t, err)
+
+	// Assert the date range selects the cars and scrapped and archived cars are left out
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+	recall, err := carAsset.IssueRecall(transactionContext, "recall1", "Tata", "Nexon", "2023-01-01", "2023-12-31", "", "Faulty airbag")
+	require.NoError(t, err)
+	require.Equal(t, 2, recall.AffectedCars)
+	require.Equal(t, "ManufacturerMSP", recall.IssuedBy.MSPID)
+	require.Equal(t, "2023-11-14T22:13:20Z", recall.IssuedAt)
+
+	name, payload := chaincodeStub.SetEventArgsForCall(chaincodeStub.SetEventCallCount() - 1)
+	require.Equal(t, contracts.EventRecallIssued, name)
+	var event contracts.RecallEvent
+	require.NoError(t, json.Unmarshal(payload, &event))
+	require.Equal(t, "recall1", event.RecallID)
+
+	_, err = carAsset.IssueRecall(transactionContext, "recall1", "Tata", "Nexon", "", "", "", "Faulty airbag")
+	requireError(t, err, contracts.CodeAlreadyExists, "the recall recall1 already exists")
+
+	_, err = carAsset.IssueRecall(transactionContext, "recall2", "Tata", "Nexon", "2025-01-01", "", "", "Loose bolts")
+	requireError(t, err, contracts.CodeConflict, "the recall recall2 affects no cars")
+
+	// Assert an explicit car list is recalled as given
+	recall, err = carAsset.IssueRecall(transactionContext, "recall3", "", "", "", "", `["car1","car4"]`, "Wiper motor")
+	require.NoError(t, err)
+	require.Equal(t, 2, recall.AffectedCars)
+	require.Equal(t, []string{"car1", "car4"}, recall.CarIds)
+
+	_, err = carAsset.IssueRecall(transactionContext, "recall4", "", "", "", "", `["car9"]`, "Wiper motor")
+	requireError(t, err, contracts.CodeNotFound, "the car car9 does not exist")
+
+	// Assert repeated cars are recalled once and archived cars in the list are left out
+	_, err = carAsset.IssueRecall(transactionContext, "recall4", "", "", "", "", `["car6"]`, "Wiper motor")
+	requireError(t, err, contracts.CodeConflict, "the recall recall4 affects no cars")
+
+	recall, err = carAsset.IssueRecall(transactionContext, "recall5", "", "", "", "", `["car4","car4","car6"]`, "Door seal")
+	require.NoError(t, err)
+	require.Equal(t, 1, recall.AffectedCars)
+	require.Equal(t, []string{"car4", "car6"}, recall.CarIds)
+
+	// Assert the open recalls of each car are found
+	recalls, err := carAsset.GetOpenRecallsForCar(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Len(t, recalls, 2)
+	require.Equal(t, "recall1", recalls[0].RecallID)
+	require.Equal(t, "recall3", recalls[1].RecallID)
+
+	recalls, err = carAsset.GetOpenRecallsForCar(transactionContext, "car3")
+	require.NoError(t, err)
+	require.Empty(t, recalls)
+
+	affected, err := carAsset.ListAffectedCars(transactionContext, "recall1", "", 10, "")
+	require.NoError(t, err)
+	require.Equal(t, int32(5), affected.FetchedRecordsCount)
+
+	queryString, _, _ := chaincodeStub.GetQueryResultWithPaginationArgsForCall(0)
+	require.JSONEq(t, `{"selector": {"assetType": {"$eq": "recallCar"}, "recallId": {"$eq": "recall1"}}}`, queryString)
+
+	_, err = carAsset.ListAffectedCars(transactionContext, "recall1", "Pending", 10, "")
+	requireError(t, err, contracts.CodeInvalidArgument, "unknown remediation status Pending, expected Open or Closed")
+
+	_, err = carAsset.ListAffectedCars(transactionContext, "recall9", "", 10, "")
+	requireError(t, err, contracts.CodeNotFound, "the recall recall9 does not exist")
+
+	// Assert only the dealer holding the car closes its recall
+	setClient(transactionContext, "DealerMSP", "dealer1")
+	_, err = carAsset.CloseRecallForCar(transactionContext, "recall1", "car1")
+	requireError(t, err, contracts.CodeUnauthorized, "only the owner of car car1 can close its recalls")
+
+	createOrder(t, transactionContext, chaincodeStub, "order1", "Kochi Motors")
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+	_, err = carAsset.MatchOrder(transactionContext, "car1", "order1")
+	require.NoError(t, err)
+
+	setClient(transactionContext, "DealerMSP", "dealer2")
+	_, err = carAsset.CloseRecallForCar(transactionContext, "recall1", "car1")
+	requireError(t, err, contracts.CodeUnauthorized, "only the owner of car car1 can close its recalls")
+
+	// Assert dealers close the recall car by car
+	setClient(transactionContext, "DealerMSP", "dealer1")
+	result, err := carAsset.CloseRecallForCar(transactionContext, "recall1", "car1")
+	require.NoError(t, err)
+	require.Equal(t, "recall recall1 is closed for car car1", result)
+
+	name, _ = chaincodeStub.SetEventArgsForCall(chaincodeStub.SetEventCallCount() - 1)
+	require.Equal(t, contracts.EventRecallClosed, name)
+
+	recalls, err = carAsset.GetOpenRecallsForCar(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Len(t, recalls, 1)
+	require.Equal(t, "recall3", recalls[0].RecallID)
+
+	_, err = carAsset.CloseRecallForCar(transactionContext, "recall1", "car1")
+	requireError(t, err, contracts.CodeConflict, "the recall recall1 is already closed for the car car1")
+
+	_, err = carAsset.CloseRecallForCar(transactionContext, "recall1", "car3")
+	requireError(t, err, contracts.CodeNotFound, "the car car3 is not affected by the recall recall1")
+
+	_, err = carAsset.ListAffectedCars(transactionContext, "recall1", contracts.RecallClosed, 10, "")
+	require.NoError(t, err)
+	queryString, _, _ = chaincodeStub.GetQueryResultWithPaginationArgsForCall(1)
+	require.JSONEq(t, `{"selector": {"assetType": {"$eq": "recallCar"}, "recallId": {"$eq": "recall1"}, "status": {"$eq": "Closed"}}}`, queryString)
+}
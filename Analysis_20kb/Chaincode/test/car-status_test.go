@@ -0,0 +1,162 @@
+package chaincodetest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"kbaauto/contracts"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCarStatusTransitions(t *testing.T) {
+	require.True(t, contracts.StatusManufactured.CanTransitionTo(contracts.StatusInTransit))
+	require.True(t, contracts.StatusAtDealer.CanTransitionTo(contracts.StatusSold))
+	require.True(t, contracts.StatusRegistered.CanTransitionTo(contracts.StatusScrapped))
+	require.True(t, contracts.StatusRegistered.CanTransitionTo(contracts.StatusSold))
+
+	require.False(t, contracts.StatusManufactured.CanTransitionTo(contracts.StatusRegistered))
+	require.False(t, contracts.StatusSold.CanTransitionTo(contracts.StatusAtDealer))
+	require.False(t, contracts.StatusScrapped.CanTransitionTo(contracts.StatusManufactured))
+}
+
+func TestCarStatusLegacyValues(t *testing.T) {
+	var car contracts.Car
+
+	err := json.Unmarshal([]byte(`{"carId":"car1","status":"In Factory"}`), &car)
+	require.NoError(t, err)
+	require.Equal(t, contracts.StatusManufactured, car.Status)
+
+	err = json.Unmarshal([]byte(`{"carId":"car1","status":"assigned to a dealer"}`), &car)
+	require.NoError(t, err)
+	require.Equal(t, contracts.StatusAtDealer, car.Status)
+
+	err = json.Unmarshal([]byte(`{"carId":"car1","status":"Registered to  Jane with plate number KL-01"}`), &car)
+	require.NoError(t, err)
+	require.Equal(t, contracts.StatusRegistered, car.Status)
+}
+
+func TestRegisterCar(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("MvdMSP")
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+	setOwner(chaincodeStub, "Jane", "0123456789abcdef")
+
+	// Assert registration is refused before the car is sold
+	bytes, err := json.Marshal(&contracts.Car{CarId: "car1", Status: contracts.StatusAtDealer})
+	require.NoError(t, err)
+	chaincodeStub.GetStateReturns(bytes, nil)
+
+	_, err = carAsset.RegisterCar(transactionContext, "car1", "KL-01")
+	requireError(t, err, contracts.CodeConflict, "the car car1 cannot move from AtDealer to Registered")
+
+	// Assert successful registration of a sold car
+	bytes, err = json.Marshal(&contracts.Car{CarId: "car1", Status: contracts.StatusSold})
+	require.NoError(t, err)
+	chaincodeStub.GetStateReturns(bytes, nil)
+
+	_, err = carAsset.RegisterCar(transactionContext, "car1", "KL-01")
+	require.NoError(t, err)
+
+	_, written := chaincodeStub.PutStateArgsForCall(0)
+	var car contracts.Car
+	require.NoError(t, json.Unmarshal(written, &car))
+	require.Equal(t, contracts.StatusRegistered, car.Status)
+	require.Empty(t, car.RegisteredOwner)
+	require.Len(t, car.OwnerCommitment, 64)
+	require.Equal(t, car.OwnerCommitment, car.OwnedBy)
+	require.Equal(t, "KL-01", car.RegistrationNumber)
+}
+
+func TestSellCar(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("DealerMSP")
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+	setOwner(chaincodeStub, "Jane", "0123456789abcdef")
+
+	// Assert a registered car cannot be sold without being deregistered first
+	bytes, err := json.Marshal(&contracts.Car{CarId: "car1", Status: contracts.StatusRegistered})
+	require.NoError(t, err)
+	chaincodeStub.GetStateReturns(bytes, nil)
+
+	_, err = carAsset.SellCar(transactionContext, "car1")
+	requireError(t, err, contracts.CodeConflict, "the car car1 cannot be sold while Registered")
+	require.Equal(t, 0, chaincodeStub.PutStateCallCount())
+
+	// Assert a car at the dealer is sold
+	bytes, err = json.Marshal(&contracts.Car{CarId: "car1", Status: contracts.StatusAtDealer})
+	require.NoError(t, err)
+	chaincodeStub.GetStateReturns(bytes, nil)
+
+	_, err = carAsset.SellCar(transactionContext, "car1")
+	require.NoError(t, err)
+
+	_, written := chaincodeStub.PutStateArgsForCall(0)
+	var car contracts.Car
+	require.NoError(t, json.Unmarshal(written, &car))
+	require.Equal(t, contracts.StatusSold, car.Status)
+}
+
+func TestLifecycleEndorsementPolicy(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("manufacturer-auto-com")
+	worldState := prepWorldState(chaincodeStub)
+	setClient(transactionContext, "manufacturer-auto-com", "user1")
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	owner := &contracts.Identity{MSPID: "manufacturer-auto-com", EnrollmentID: "user1"}
+	bytes, err := json.Marshal(&contracts.Car{CarId: "car1", Status: contracts.StatusManufactured, Owner: owner})
+	require.NoError(t, err)
+	worldState["car1"] = bytes
+
+	// Assert a car without a key-level policy has no principals
+	policy, err := carAsset.GetCarEndorsementPolicy(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Empty(t, policy.Principals)
+
+	// Assert shipping applies the template using the MSP IDs of the caller's network
+	_, err = carAsset.ShipCar(transactionContext, "car1")
+	require.NoError(t, err)
+
+	policy, err = carAsset.GetCarEndorsementPolicy(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Equal(t, int32(1), policy.Required)
+	require.Equal(t, []*contracts.EndorsementPrincipal{{MSPID: "manufacturer-auto-com", Role: "peer"}}, policy.Principals)
+
+	// Assert an explicit policy replaces the template
+	_, err = carAsset.SetCarEndorsementPolicy(transactionContext, "car1", []string{"manufacturer-auto-com", "dealer-auto-com"})
+	require.NoError(t, err)
+
+	policy, err = carAsset.GetCarEndorsementPolicy(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Equal(t, int32(2), policy.Required)
+	require.Len(t, policy.Principals, 2)
+
+	// Assert organisations outside the known networks are refused
+	_, err = carAsset.SetCarEndorsementPolicy(transactionContext, "car1", []string{"manufacturer-auto-com", "OtherMSP"})
+	requireError(t, err, contracts.CodeInvalidArgument, "the MSPID OtherMSP does not belong to a known organisation")
+
+	// Assert another organisation cannot take the car over
+	setClient(transactionContext, "mvd-auto-com", "user1")
+	_, err = carAsset.SetCarEndorsementPolicy(transactionContext, "car1", []string{"mvd-auto-com"})
+	requireError(t, err, contracts.CodeUnauthorized, "user under following MSPID: mvd-auto-com can't perform this action")
+
+	setClient(transactionContext, "manufacturer-auto-com", "user2")
+	_, err = carAsset.SetCarEndorsementPolicy(transactionContext, "car1", []string{"manufacturer-auto-com"})
+	requireError(t, err, contracts.CodeUnauthorized, "unauthorized user: must have 'role=admin'")
+
+	// Assert an admin can change the policy of any car
+	setAdmin(transactionContext, "user2")
+	_, err = carAsset.SetCarEndorsementPolicy(transactionContext, "car1", []string{"manufacturer-auto-com"})
+	require.NoError(t, err)
+
+	policy, err = carAsset.GetCarEndorsementPolicy(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Equal(t, []*contracts.EndorsementPrincipal{{MSPID: "manufacturer-auto-com", Role: "peer"}}, policy.Principals)
+}
@@ -0,0 +1,74 @@
+package chaincodetest
+
+import (
+	"testing"
+
+	"kbaauto/contracts"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDecodeVIN(t *testing.T) {
+	vin, err := contracts.DecodeVIN("1M8GDM9AXKP042788")
+	require.NoError(t, err)
+	require.Equal(t, &contracts.VIN{WMI: "1M8", ModelYear: 1989}, vin)
+
+	// Assert a letter in position 7 selects the second model year cycle
+	vin, err = contracts.DecodeVIN("1HGCV1F33LA000001")
+	require.NoError(t, err)
+	require.Equal(t, 2020, vin.ModelYear)
+
+	_, err = contracts.DecodeVIN("Car2-1")
+	requireError(t, err, contracts.CodeInvalidArgument, "the car ID Car2-1 is not a valid VIN, it must have 17 characters")
+
+	_, err = contracts.DecodeVIN("1M8GDM9AXKP04278O")
+	requireError(t, err, contracts.CodeInvalidArgument, "the VIN 1M8GDM9AXKP04278O contains the invalid character O")
+
+	_, err = contracts.DecodeVIN("1M8GDM9A1KP042788")
+	requireError(t, err, contracts.CodeInvalidArgument, "the VIN 1M8GDM9A1KP042788 has check digit 1, expected X")
+}
+
+func TestStrictVIN(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("ManufacturerMSP")
+	worldState := prepWorldState(chaincodeStub)
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000}, nil)
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	// Assert any car ID is accepted until strict mode is on
+	_, err := carAsset.CreateCar(transactionContext, "Car2-1", "Honda", "Civic", "Blue", "Factory-01", "2020-01-02")
+	require.NoError(t, err)
+
+	_, err = carAsset.SetStrictVIN(transactionContext, true)
+	requireError(t, err, contracts.CodeUnauthorized, "unauthorized user: must have 'role=admin'")
+
+	setAdmin(transactionContext, "user1")
+	_, err = carAsset.SetStrictVIN(transactionContext, true)
+	require.NoError(t, err)
+
+	_, err = carAsset.CreateCar(transactionContext, "Car2-2", "Honda", "Civic", "Blue", "Factory-01", "2020-01-02")
+	requireError(t, err, contracts.CodeInvalidArgument, "the car ID Car2-2 is not a valid VIN, it must have 17 characters")
+
+	_, err = carAsset.CreateCar(transactionContext, "1HGCV1F33LA000001", "Honda", "Civic", "Blue", "Factory-01", "2020-02-30")
+	requireError(t, err, contracts.CodeInvalidArgument, "invalid car. dateOfManufacture must be a YYYY-MM-DD date")
+
+	_, err = carAsset.CreateCar(transactionContext, "1HGCV1F33LA000001", "Honda", "Civic", "Blue", "Factory-01", "2017-05-01")
+	requireError(t, err, contracts.CodeInvalidArgument, "the model year 2020 of VIN 1HGCV1F33LA000001 does not match the date of manufacture 2017-05-01")
+
+	// Assert the decoded VIN is stored with the car
+	_, err = carAsset.CreateCar(transactionContext, "1HGCV1F33LA000001", "Honda", "Civic", "Blue", "Factory-01", "2019-08-01")
+	require.NoError(t, err)
+
+	car, err := carAsset.ReadCar(transactionContext, "1HGCV1F33LA000001")
+	require.NoError(t, err)
+	require.Equal(t, "1HG", car.WMI)
+	require.Equal(t, 2020, car.ModelYear)
+
+	_, err = carAsset.UpdateCar(transactionContext, "1HGCV1F33LA000001", "Honda", "Civic", "Red", "Factory-01", "2024-01-01")
+	requireError(t, err, contracts.CodeInvalidArgument, "the date of manufacture 2024-01-01 is in the future")
+	require.NotContains(t, worldState, "Car2-2")
+}
@@ -0,0 +1,75 @@
+package chaincodetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"kbaauto/contracts"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompression(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("ManufacturerMSP")
+	worldState := prepWorldState(chaincodeStub)
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+	setAdmin(transactionContext, "user1")
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	car := &contracts.Car{AssetType: "car", CarId: "car1", Make: "Honda", Color: "Red", Status: contracts.StatusManufactured}
+	for i := 0; i < 20; i++ {
+		car.Documents = append(car.Documents, &contracts.Document{
+			Name:      fmt.Sprintf("inspection-%d.pdf", i),
+			SHA256:    strings.Repeat(fmt.Sprintf("%x", i%16), 64),
+			MediaType: "application/pdf",
+			Size:      1024,
+		})
+	}
+	bytes, err := json.Marshal(car)
+	require.NoError(t, err)
+	worldState["car1"] = bytes
+
+	_, err = carAsset.SetCompression(transactionContext, -1)
+	requireError(t, err, contracts.CodeInvalidArgument, "the compression threshold cannot be negative")
+
+	_, err = carAsset.SetCompression(transactionContext, 1024)
+	require.NoError(t, err)
+
+	// Assert a large car is packed with its queryable fields left in plain JSON
+	_, err = carAsset.PatchCar(transactionContext, "car1", `{"color":"Blue"}`, 0)
+	require.NoError(t, err)
+	require.Less(t, len(worldState["car1"]), len(bytes))
+
+	var stored map[string]interface{}
+	require.NoError(t, json.Unmarshal(worldState["car1"], &stored))
+	require.Equal(t, "Honda", stored["make"])
+	require.Equal(t, "Blue", stored["color"])
+	require.Contains(t, stored, "packed")
+	require.NotContains(t, stored, "documents")
+
+	read, err := carAsset.ReadCar(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Equal(t, "Blue", read.Color)
+	require.Len(t, read.Documents, 20)
+
+	// Assert payload chunks are compressed behind the gzip header byte
+	payload := strings.Repeat("compressible ", 1000)
+	_, err = carAsset.PutCarPayload(transactionContext, "car1", "brochure", payload, true)
+	require.NoError(t, err)
+
+	for key, value := range worldState {
+		if strings.Contains(key, "payloadchunk") {
+			require.Equal(t, byte(0x01), value[0])
+			require.Less(t, len(value), 8192)
+		}
+	}
+
+	stored2, err := carAsset.GetCarPayload(transactionContext, "car1", "brochure")
+	require.NoError(t, err)
+	require.Equal(t, payload, stored2)
+}
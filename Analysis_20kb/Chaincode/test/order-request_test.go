@@ -0,0 +1,88 @@
+package chaincodetest
+
+import (
+	"testing"
+
+	"kbaauto/contracts"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreateOrderRequest(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("DealerMSP")
+	prepWorldState(chaincodeStub)
+	prepPrivateData(chaincodeStub)
+	setClient(transactionContext, "DealerMSP", "dealer1")
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000}, nil)
+
+	// Create OrderContract instance
+	orderAsset := contracts.OrderContract{}
+
+	// Assert the full order is taken from the JSON transient entry
+	chaincodeStub.GetTransientReturns(map[string][]byte{
+		"order": []byte(`{"make":"Tata","model":"Nexon","color":"White","dealerName":"KBA3","quantity":3,"options":["Sunroof","Tow bar"],"deliveryDate":"2024-03-01","notes":"Deliver to the Kochi branch"}`),
+	}, nil)
+	_, err := orderAsset.CreateOrder(transactionContext, "order1")
+	require.NoError(t, err)
+
+	order, err := orderAsset.ReadOrder(transactionContext, "order1")
+	require.NoError(t, err)
+	require.Equal(t, 3, order.Quantity)
+	require.Equal(t, []string{"Sunroof", "Tow bar"}, order.Options)
+	require.Equal(t, "2024-03-01", order.DeliveryDate)
+	require.Equal(t, "Deliver to the Kochi branch", order.Notes)
+
+	// Assert the separate keys still work and order one car
+	createOrder(t, transactionContext, chaincodeStub, "order2", "KBA3")
+	order, err = orderAsset.ReadOrder(transactionContext, "order2")
+	require.NoError(t, err)
+	require.Equal(t, 1, order.Quantity)
+
+	// Assert a JSON order without a quantity is for one car
+	chaincodeStub.GetTransientReturns(map[string][]byte{
+		"order": []byte(`{"make":"Tata","model":"Nexon","color":"White","dealerName":"KBA3"}`),
+	}, nil)
+	_, err = orderAsset.CreateOrder(transactionContext, "order4")
+	require.NoError(t, err)
+
+	order, err = orderAsset.ReadOrder(transactionContext, "order4")
+	require.NoError(t, err)
+	require.Equal(t, 1, order.Quantity)
+
+	// Assert the order is checked against the schema
+	chaincodeStub.GetTransientReturns(map[string][]byte{
+		"order": []byte(`{"make":"Tata","model":"Nexon","colour":"White"}`),
+	}, nil)
+	_, err = orderAsset.CreateOrder(transactionContext, "order3")
+	requireError(t, err, contracts.CodeInvalidArgument, `could not parse the order. json: unknown field "colour"`)
+
+	chaincodeStub.GetTransientReturns(map[string][]byte{
+		"order": []byte(`{"make":"Tata","model":"Nexon","quantity":"two"}`),
+	}, nil)
+	_, err = orderAsset.CreateOrder(transactionContext, "order3")
+	requireError(t, err, contracts.CodeInvalidArgument, "could not parse the order. json: cannot unmarshal string into Go struct field orderRequest.quantity of type int")
+
+	chaincodeStub.GetTransientReturns(map[string][]byte{
+		"order": []byte(`{"make":"Tata","model":"Nexon","color":"White","quantity":500,"options":["Sunroof",""],"deliveryDate":"01/03/2024"}`),
+	}, nil)
+	_, err = orderAsset.CreateOrder(transactionContext, "order3")
+	requireError(t, err, contracts.CodeInvalidArgument, "invalid order. dealerName is required; options[1] is required; deliveryDate must be a YYYY-MM-DD date; quantity must be between 1 and 100")
+
+	chaincodeStub.GetTransientReturns(map[string][]byte{
+		"order": []byte(`{"make":"Tata","model":"Nexon","color":"White","dealerName":"KBA3","quantity":-1}`),
+	}, nil)
+	_, err = orderAsset.CreateOrder(transactionContext, "order3")
+	requireError(t, err, contracts.CodeInvalidArgument, "invalid order. quantity must be between 1 and 100")
+
+	chaincodeStub.GetTransientReturns(map[string][]byte{
+		"order": []byte(`{"make":"Tata","model":"Nexon","color":"White","dealerName":"KBA3","quantity":0}`),
+	}, nil)
+	_, err = orderAsset.CreateOrder(transactionContext, "order3")
+	requireError(t, err, contracts.CodeInvalidArgument, "invalid order. quantity must be between 1 and 100")
+
+	chaincodeStub.GetTransientReturns(map[string][]byte{}, nil)
+	_, err = orderAsset.CreateOrder(transactionContext, "order3")
+	requireError(t, err, contracts.CodeInvalidArgument, "please provide the order, or the private data of make, model, color, dealerName")
+}
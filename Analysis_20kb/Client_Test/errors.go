@@ -0,0 +1,88 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
+	"google.golang.org/grpc/status"
+)
+
+// Error codes returned by the chaincode transactions
+const (
+	CodeNotFound        = "NOT_FOUND"
+	CodeAlreadyExists   = "ALREADY_EXISTS"
+	CodeUnauthorized    = "UNAUTHORIZED"
+	CodeInvalidArgument = "INVALID_ARGUMENT"
+	CodeConflict        = "CONFLICT"
+	CodeInternal        = "INTERNAL"
+)
+
+// ContractError is an error raised by a chaincode transaction, which the chaincode returns as
+// {"code":"NOT_FOUND","message":"the car car1 does not exist"}
+type ContractError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *ContractError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// parseContractError finds a ContractError in a peer message such as "chaincode response 500, {...}"
+func parseContractError(message string) *ContractError {
+	start := strings.Index(message, `{"code":`)
+	if start < 0 {
+		return nil
+	}
+
+	var contractErr ContractError
+	err := json.NewDecoder(strings.NewReader(message[start:])).Decode(&contractErr)
+	if err != nil || contractErr.Code == "" {
+		return nil
+	}
+	return &contractErr
+}
+
+// decodeContractError maps an error from the gateway back to the ContractError raised by the
+// chaincode. Endorsement and evaluation errors carry each peer's response in their gRPC status
+// details; the first one holding a ContractError is returned.
+func decodeContractError(err error) (*ContractError, bool) {
+	if err == nil {
+		return nil, false
+	}
+
+	var contractErr *ContractError
+	if errors.As(err, &contractErr) {
+		return contractErr, true
+	}
+
+	var messages []string
+	if grpcStatus, ok := status.FromError(err); ok {
+		for _, detail := range grpcStatus.Details() {
+			if errorDetail, ok := detail.(*gateway.ErrorDetail); ok {
+				messages = append(messages, errorDetail.Message)
+			}
+		}
+		messages = append(messages, grpcStatus.Message())
+	}
+	messages = append(messages, err.Error())
+
+	for _, message := range messages {
+		if contractErr := parseContractError(message); contractErr != nil {
+			return contractErr, true
+		}
+	}
+	return nil, false
+}
+
+// errorCode returns the code of the ContractError behind err, or an empty string if there is none
+func errorCode(err error) string {
+	contractErr, ok := decodeContractError(err)
+	if !ok {
+		return ""
+	}
+	return contractErr.Code
+}
@@ -0,0 +1,212 @@
+package contracts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-chaincode-go/pkg/statebased"
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+	"github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-protos-go/msp"
+)
+
+// EndorsementPrincipal is an organisation role that must endorse changes to a car
+type EndorsementPrincipal struct {
+	MSPID string `json:"mspId"`
+	Role  string `json:"role"`
+}
+
+// EndorsementPolicy describes the key-level endorsement policy of a car. A car without
+// principals is governed by the chaincode endorsement policy.
+type EndorsementPolicy struct {
+	CarId      string                  `json:"carId"`
+	Required   int32                   `json:"required"`
+	Principals []*EndorsementPrincipal `json:"principals"`
+}
+
+// orgGroups lists the MSP IDs of each organisation, in the same network order for every organisation
+var orgGroups = map[string][]string{
+	"manufacturer": manufacturerMSPs,
+	"dealer":       dealerMSPs,
+	"mvd":          mvdMSPs,
+}
+
+// endorsementTemplates lists the organisations that must endorse changes to a car in each lifecycle stage
+var endorsementTemplates = map[CarStatus][]string{
+	StatusManufactured: {"manufacturer"},
+	StatusInTransit:    {"manufacturer"},
+	StatusAtDealer:     {"manufacturer", "dealer"},
+	StatusSold:         {"dealer", "mvd"},
+	StatusRegistered:   {"mvd"},
+	StatusScrapped:     {"mvd"},
+}
+
+// networkMSPs resolves organisation names to the MSP IDs used on the same network as the client
+func networkMSPs(clientOrgID string, orgs ...string) ([]string, error) {
+	network := -1
+	for _, mspIDs := range orgGroups {
+		for i, mspID := range mspIDs {
+			if mspID == clientOrgID {
+				network = i
+			}
+		}
+	}
+	if network < 0 {
+		return nil, errorf(CodeUnauthorized, "the MSPID %v does not belong to a known network", clientOrgID)
+	}
+
+	var resolved []string
+	for _, org := range orgs {
+		resolved = append(resolved, orgGroups[org][network])
+	}
+	return resolved, nil
+}
+
+func setEndorsingOrgs(ctx contractapi.TransactionContextInterface, carID string, mspIDs []string) error {
+	endorsementPolicy, err := statebased.NewStateEP(nil)
+	if err != nil {
+		return errorf(CodeInternal, "could not create the endorsement policy. %s", err)
+	}
+
+	err = endorsementPolicy.AddOrgs(statebased.RoleTypePeer, mspIDs...)
+	if err != nil {
+		return errorf(CodeInternal, "could not add the organisations to the endorsement policy. %s", err)
+	}
+
+	policy, err := endorsementPolicy.Policy()
+	if err != nil {
+		return errorf(CodeInternal, "could not create the endorsement policy. %s", err)
+	}
+
+	err = ctx.GetStub().SetStateValidationParameter(carID, policy)
+	if err != nil {
+		return errorf(CodeInternal, "failed to set endorsement policy: %s", err)
+	}
+	return nil
+}
+
+// applyEndorsementTemplate sets the endorsement policy for the lifecycle stage the car is in
+func applyEndorsementTemplate(ctx contractapi.TransactionContextInterface, car *Car) error {
+	orgs, ok := endorsementTemplates[car.Status]
+	if !ok {
+		return nil
+	}
+
+	clientOrgID, err := ctx.GetClientIdentity().GetMSPID()
+	if err != nil {
+		return errorf(CodeInternal, "could not fetch client identity. %s", err)
+	}
+
+	mspIDs, err := networkMSPs(clientOrgID, orgs...)
+	if err != nil {
+		return err
+	}
+
+	return setEndorsingOrgs(ctx, car.CarId, mspIDs)
+}
+
+func describeEndorsementPolicy(carID string, policy []byte) (*EndorsementPolicy, error) {
+	description := &EndorsementPolicy{CarId: carID, Principals: []*EndorsementPrincipal{}}
+	if len(policy) == 0 {
+		return description, nil
+	}
+
+	envelope := &common.SignaturePolicyEnvelope{}
+	err := proto.Unmarshal(policy, envelope)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not unmarshal the endorsement policy. %s", err)
+	}
+
+	description.Required = envelope.GetRule().GetNOutOf().GetN()
+	for _, identity := range envelope.GetIdentities() {
+		role := &msp.MSPRole{}
+		err = proto.Unmarshal(identity.GetPrincipal(), role)
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not unmarshal the endorsement principal. %s", err)
+		}
+		description.Principals = append(description.Principals, &EndorsementPrincipal{
+			MSPID: role.GetMspIdentifier(),
+			Role:  strings.ToLower(role.GetRole().String()),
+		})
+	}
+	return description, nil
+}
+
+// knownMSP returns true when the MSP ID belongs to one of the organisations of a known network
+func knownMSP(mspID string) bool {
+	for _, mspIDs := range orgGroups {
+		for _, known := range mspIDs {
+			if known == mspID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SetCarEndorsementPolicy requires every listed organisation to endorse future changes to the car.
+// Only the owner of the car or an admin can change it.
+func (c *CarContract) SetCarEndorsementPolicy(ctx contractapi.TransactionContextInterface, carID string, mspIDs []string) (string, error) {
+	err := checkAccess(ctx, "CarContract:SetCarEndorsementPolicy")
+	if err != nil {
+		return "", err
+	}
+
+	if len(mspIDs) == 0 {
+		return "", errorf(CodeInvalidArgument, "the endorsement policy needs at least one organisation")
+	}
+	for _, mspID := range mspIDs {
+		if !knownMSP(mspID) {
+			return "", errorf(CodeInvalidArgument, "the MSPID %v does not belong to a known organisation", mspID)
+		}
+	}
+
+	car, err := c.readActiveCar(ctx, carID)
+	if err != nil {
+		return "", err
+	}
+
+	caller, err := clientIdentity(ctx)
+	if err != nil {
+		return "", err
+	}
+	if car.Owner == nil || *car.Owner != caller {
+		err = checkAccess(ctx, "CarContract:ChangeAnyEndorsementPolicy")
+		if err != nil {
+			return "", err
+		}
+	}
+
+	err = setEndorsingOrgs(ctx, carID, mspIDs)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Endorsement policy for %v set to %v", carID, strings.Join(mspIDs, ", ")), nil
+}
+
+// GetCarEndorsementPolicy describes the organisations that must endorse changes to the car
+func (c *CarContract) GetCarEndorsementPolicy(ctx contractapi.TransactionContextInterface, carID string) (*EndorsementPolicy, error) {
+	err := checkAccess(ctx, "CarContract:GetCarEndorsementPolicy")
+	if err != nil {
+		return nil, err
+	}
+
+	return c.carEndorsementPolicy(ctx, carID)
+}
+
+func (c *CarContract) carEndorsementPolicy(ctx contractapi.TransactionContextInterface, carID string) (*EndorsementPolicy, error) {
+	exists, err := c.carExists(ctx, carID)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, errorf(CodeNotFound, "the car %s does not exist", carID)
+	}
+
+	policy, err := ctx.GetStub().GetStateValidationParameter(carID)
+	if err != nil {
+		return nil, errorf(CodeInternal, "failed to get endorsement policy for %s: %s", carID, err)
+	}
+
+	return describeEndorsementPolicy(carID, policy)
+}
@@ -0,0 +1,329 @@
+package contracts
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+	"github.com/hyperledger/fabric-protos-go/ledger/queryresult"
+)
+
+// FieldChange is a car field changed by a transaction. From and To hold the JSON encoded
+// values and are left out when the field was added or removed.
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from,omitempty" metadata:",optional"`
+	To    string `json:"to,omitempty" metadata:",optional"`
+}
+
+// HistoryQueryResult is one version of a car. Deletes have no record.
+type HistoryQueryResult struct {
+	Record    *Car           `json:"record,omitempty" metadata:",optional"`
+	TxId      string         `json:"txId"`
+	Timestamp string         `json:"timestamp"`
+	Epoch     int64          `json:"epoch"`
+	IsDelete  bool           `json:"isDelete"`
+	Changes   []*FieldChange `json:"changes"`
+}
+
+// HistoryPage is one page of a car history, newest version first. An empty bookmark marks the last page.
+type HistoryPage struct {
+	Records             []*HistoryQueryResult `json:"records"`
+	FetchedRecordsCount int32                 `json:"fetchedRecordsCount"`
+	Bookmark            string                `json:"bookmark"`
+}
+
+type historyEntry struct {
+	result *HistoryQueryResult
+	time   time.Time
+}
+
+// parseHistoryTime accepts RFC3339 or seconds since the epoch; an empty value gives the zero time
+func parseHistoryTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err == nil {
+		return time.Unix(seconds, 0).UTC(), nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, errorf(CodeInvalidArgument, "invalid time %q, expected RFC3339 or seconds since the epoch", value)
+	}
+	return parsed.UTC(), nil
+}
+
+func carFields(car *Car) (map[string]json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	if car == nil {
+		return fields, nil
+	}
+
+	carJSON, err := json.Marshal(car)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not marshal the car. %s", err)
+	}
+
+	err = json.Unmarshal(carJSON, &fields)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not unmarshal the data. %s", err)
+	}
+	return fields, nil
+}
+
+// diffFields lists the fields that differ between two versions of a car, sorted by name
+func diffFields(previous, next map[string]json.RawMessage) []*FieldChange {
+	var names []string
+	for name := range previous {
+		names = append(names, name)
+	}
+	for name := range next {
+		if _, ok := previous[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	changes := []*FieldChange{}
+	for _, name := range names {
+		if string(previous[name]) != string(next[name]) {
+			changes = append(changes, &FieldChange{
+				Field: name,
+				From:  string(previous[name]),
+				To:    string(next[name]),
+			})
+		}
+	}
+	return changes
+}
+
+// historyResult decodes one version of a car from the history of its key
+func historyResult(response *queryresult.KeyModification) (*historyEntry, error) {
+	var car *Car
+	if !response.IsDelete && len(response.Value) > 0 {
+		car = &Car{}
+		err := unmarshalCar(response.Value, car)
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not unmarshal the data. %s", err)
+		}
+	}
+
+	timestamp := response.Timestamp.AsTime().UTC()
+	return &historyEntry{
+		result: &HistoryQueryResult{
+			Record:    car,
+			TxId:      response.TxId,
+			Timestamp: timestamp.Format(time.RFC3339Nano),
+			Epoch:     timestamp.Unix(),
+			IsDelete:  response.IsDelete,
+		},
+		time: timestamp,
+	}, nil
+}
+
+// readCarHistory returns every version of a car, oldest first, each with the changes to the version
+// before it. GetHistoryForKey returns the versions of a key newest first.
+func (c *CarContract) readCarHistory(ctx contractapi.TransactionContextInterface, carID string) ([]*historyEntry, error) {
+	resultsIterator, err := ctx.GetStub().GetHistoryForKey(carID)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not get the data. %s", err)
+	}
+	defer resultsIterator.Close()
+
+	var entries []*historyEntry
+	for resultsIterator.HasNext() {
+		response, err := resultsIterator.Next()
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not get the value of resultsIterator. %s", err)
+		}
+
+		entry, err := historyResult(response)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
+	}
+
+	previous := map[string]json.RawMessage{}
+	for _, entry := range entries {
+		fields, err := carFields(entry.result.Record)
+		if err != nil {
+			return nil, err
+		}
+		entry.result.Changes = diffFields(previous, fields)
+		previous = fields
+	}
+
+	return entries, nil
+}
+
+// GetCarHistory returns every version of a car, oldest first, each with the changes to the version before it
+func (c *CarContract) GetCarHistory(ctx contractapi.TransactionContextInterface, carID string) ([]*HistoryQueryResult, error) {
+	err := checkAccess(ctx, "CarContract:GetCarHistory")
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := c.readCarHistory(ctx, carID)
+	if err != nil {
+		return nil, err
+	}
+
+	records := []*HistoryQueryResult{}
+	for _, entry := range entries {
+		records = append(records, entry.result)
+	}
+	return records, nil
+}
+
+// GetCarHistoryPage returns one page of the versions of a car committed between from and to, newest
+// first as GetHistoryForKey returns them. Both bounds are inclusive, optional and given as RFC3339 or seconds
+// since the epoch. The bookmark is the ID of the last transaction returned; the history iterator
+// cannot seek, so the next page skips the versions already returned without decoding them and stops
+// reading once the page is full.
+func (c *CarContract) GetCarHistoryPage(ctx contractapi.TransactionContextInterface, carID string, from string, to string, pageSize int32, bookmark string) (*HistoryPage, error) {
+	err := checkAccess(ctx, "CarContract:GetCarHistoryPage")
+	if err != nil {
+		return nil, err
+	}
+
+	if pageSize <= 0 || pageSize > maxCarPageSize {
+		return nil, errorf(CodeInvalidArgument, "the page size must be between 1 and %d", maxCarPageSize)
+	}
+
+	fromTime, err := parseHistoryTime(from)
+	if err != nil {
+		return nil, err
+	}
+	toTime, err := parseHistoryTime(to)
+	if err != nil {
+		return nil, err
+	}
+	if !fromTime.IsZero() && !toTime.IsZero() && toTime.Before(fromTime) {
+		return nil, errorf(CodeInvalidArgument, "the history range ends before it starts")
+	}
+
+	resultsIterator, err := ctx.GetStub().GetHistoryForKey(carID)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not get the data. %s", err)
+	}
+	defer resultsIterator.Close()
+
+	if bookmark != "" {
+		found := false
+		for !found && resultsIterator.HasNext() {
+			response, err := resultsIterator.Next()
+			if err != nil {
+				return nil, errorf(CodeInternal, "could not get the value of resultsIterator. %s", err)
+			}
+			found = response.TxId == bookmark
+		}
+		if !found {
+			return nil, errorf(CodeInvalidArgument, "invalid bookmark %s", bookmark)
+		}
+	}
+
+	// Read one version past the page, as the oldest version on the page is diffed against it
+	var entries []*historyEntry
+	more := false
+	for resultsIterator.HasNext() {
+		response, err := resultsIterator.Next()
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not get the value of resultsIterator. %s", err)
+		}
+
+		timestamp := response.Timestamp.AsTime().UTC()
+		if !toTime.IsZero() && timestamp.After(toTime) {
+			continue
+		}
+
+		entry, err := historyResult(response)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+
+		if !fromTime.IsZero() && timestamp.Before(fromTime) {
+			break
+		}
+		if len(entries) > int(pageSize) {
+			more = true
+			break
+		}
+	}
+
+	records := []*HistoryQueryResult{}
+	for i, entry := range entries {
+		if len(records) == int(pageSize) || (!fromTime.IsZero() && entry.time.Before(fromTime)) {
+			break
+		}
+
+		var older *Car
+		if i+1 < len(entries) {
+			older = entries[i+1].result.Record
+		}
+		previous, err := carFields(older)
+		if err != nil {
+			return nil, err
+		}
+		fields, err := carFields(entry.result.Record)
+		if err != nil {
+			return nil, err
+		}
+		entry.result.Changes = diffFields(previous, fields)
+		records = append(records, entry.result)
+	}
+
+	nextBookmark := ""
+	if more {
+		nextBookmark = records[len(records)-1].TxId
+	}
+	return &HistoryPage{
+		Records:             records,
+		FetchedRecordsCount: int32(len(records)),
+		Bookmark:            nextBookmark,
+	}, nil
+}
+
+// GetCarAsOf returns the car as it was at the given time, given as RFC3339 or seconds since the epoch
+func (c *CarContract) GetCarAsOf(ctx contractapi.TransactionContextInterface, carID string, asOf string) (*Car, error) {
+	err := checkAccess(ctx, "CarContract:GetCarAsOf")
+	if err != nil {
+		return nil, err
+	}
+
+	if asOf == "" {
+		return nil, errorf(CodeInvalidArgument, "the time to read the car at is required")
+	}
+	asOfTime, err := parseHistoryTime(asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := c.readCarHistory(ctx, carID)
+	if err != nil {
+		return nil, err
+	}
+
+	var car *Car
+	for _, entry := range entries {
+		if entry.time.After(asOfTime) {
+			break
+		}
+		car = entry.result.Record
+	}
+
+	if car == nil {
+		return nil, errorf(CodeNotFound, "the car %s did not exist at %s", carID, asOfTime.Format(time.RFC3339))
+	}
+	return car, nil
+}
@@ -0,0 +1,259 @@
+package contracts
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+// Header bytes of encoded state values. Plain JSON values start with '{' and carry no header.
+const (
+	encodingIdentity byte = 0x00
+	encodingGzip     byte = 0x01
+	encodingProtobuf byte = 0x02
+)
+
+// queryableCarFields stay uncompressed in a packed car so the CouchDB indexes and selectors keep working
+var queryableCarFields = []string{"assetType", "carId", "color", "dateOfManufacture", "make", "model", "ownedBy", "status", "archive"}
+
+// packedCar is the stored form of a compressed car: the queryable fields next to the whole car,
+// gzipped behind its header byte
+type packedCar struct {
+	Packed []byte `json:"packed"`
+}
+
+// encodeValue prefixes a value with its encoding, gzipping it when it has at least threshold bytes
+// and compression makes it smaller. gzip output has no timestamp, so every endorser writes the same bytes.
+func encodeValue(value []byte, threshold int) ([]byte, error) {
+	if threshold > 0 && len(value) >= threshold {
+		var buffer bytes.Buffer
+		buffer.WriteByte(encodingGzip)
+
+		writer := gzip.NewWriter(&buffer)
+		_, err := writer.Write(value)
+		if err == nil {
+			err = writer.Close()
+		}
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not compress the value. %s", err)
+		}
+
+		if buffer.Len() < len(value)+1 {
+			return buffer.Bytes(), nil
+		}
+	}
+
+	return append([]byte{encodingIdentity}, value...), nil
+}
+
+// decodeValue reverses encodeValue; values without a header byte are returned as they are
+func decodeValue(value []byte) ([]byte, error) {
+	if len(value) == 0 {
+		return value, nil
+	}
+
+	switch value[0] {
+	case encodingIdentity:
+		return value[1:], nil
+	case encodingGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(value[1:]))
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not decompress the value. %s", err)
+		}
+		defer reader.Close()
+
+		decoded, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not decompress the value. %s", err)
+		}
+		return decoded, nil
+	default:
+		return value, nil
+	}
+}
+
+// compressionThreshold returns the configured threshold, 0 when compression is off
+func compressionThreshold(ctx contractapi.TransactionContextInterface) (int, error) {
+	config, err := readConfig(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return config.CompressionThreshold, nil
+}
+
+// packCar packs a JSON car of at least threshold bytes: the queryable fields stay plain JSON and
+// the whole car is stored compressed next to them
+func packCar(carJSON []byte, threshold int) ([]byte, error) {
+	if threshold <= 0 || len(carJSON) < threshold {
+		return carJSON, nil
+	}
+
+	encoded, err := encodeValue(carJSON, threshold)
+	if err != nil {
+		return nil, err
+	}
+	if encoded[0] != encodingGzip {
+		return carJSON, nil
+	}
+
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(carJSON, &fields)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not unmarshal the data. %s", err)
+	}
+
+	packed := map[string]interface{}{"packed": encoded}
+	for _, field := range queryableCarFields {
+		if value, ok := fields[field]; ok {
+			packed[field] = value
+		}
+	}
+
+	packedJSON, _ := json.Marshal(packed)
+	if len(packedJSON) >= len(carJSON) {
+		return carJSON, nil
+	}
+	return packedJSON, nil
+}
+
+// encodeProtobuf puts the protobuf header byte in front of a message and gzips the whole value
+// when it has at least threshold bytes. Nothing stays queryable, which LevelDB does not need.
+func encodeProtobuf(message []byte, threshold int) ([]byte, error) {
+	value := append([]byte{encodingProtobuf}, message...)
+
+	encoded, err := encodeValue(value, threshold)
+	if err != nil {
+		return nil, err
+	}
+	if encoded[0] == encodingGzip {
+		return encoded, nil
+	}
+	return value, nil
+}
+
+// protobufMessage returns the message of a value written by encodeProtobuf, and false for JSON values
+func protobufMessage(data []byte) ([]byte, bool, error) {
+	if len(data) > 0 && data[0] == encodingGzip {
+		decoded, err := decodeValue(data)
+		if err != nil {
+			return nil, false, err
+		}
+		data = decoded
+	}
+
+	if len(data) > 0 && data[0] == encodingProtobuf {
+		return data[1:], true, nil
+	}
+	return data, false, nil
+}
+
+// marshalCar returns the world state value of a car in the configured state encoding, compressed
+// when it has at least the compression threshold
+func marshalCar(ctx contractapi.TransactionContextInterface, car *Car) ([]byte, error) {
+	config, err := readConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	serializer, err := SerializerFor(config.StateEncoding)
+	if err != nil {
+		return nil, err
+	}
+	data, err := serializer.MarshalCar(car)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not marshal the car. %s", err)
+	}
+
+	if config.StateEncoding == StateEncodingProtobuf {
+		return encodeProtobuf(data, config.CompressionThreshold)
+	}
+	return packCar(data, config.CompressionThreshold)
+}
+
+// unmarshalCar reads a car stored in any state encoding, plain, packed or compressed
+func unmarshalCar(data []byte, car *Car) error {
+	data, isProtobuf, err := protobufMessage(data)
+	if err != nil {
+		return err
+	}
+	if isProtobuf {
+		return protobufSerializer{}.UnmarshalCar(data, car)
+	}
+
+	var packed packedCar
+	err = json.Unmarshal(data, &packed)
+	if err != nil {
+		return err
+	}
+
+	if len(packed.Packed) > 0 {
+		data, err = decodeValue(packed.Packed)
+		if err != nil {
+			return err
+		}
+	}
+	return json.Unmarshal(data, car)
+}
+
+// marshalOrder returns the private data value of an order in the configured state encoding
+func marshalOrder(ctx contractapi.TransactionContextInterface, order *Order) ([]byte, error) {
+	config, err := readConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	serializer, err := SerializerFor(config.StateEncoding)
+	if err != nil {
+		return nil, err
+	}
+	data, err := serializer.MarshalOrder(order)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not marshal the order. %s", err)
+	}
+
+	if config.StateEncoding == StateEncodingProtobuf {
+		return encodeProtobuf(data, 0)
+	}
+	return data, nil
+}
+
+// unmarshalOrder reads an order stored in any state encoding
+func unmarshalOrder(data []byte, order *Order) error {
+	data, isProtobuf, err := protobufMessage(data)
+	if err != nil {
+		return err
+	}
+	if isProtobuf {
+		return protobufSerializer{}.UnmarshalOrder(data, order)
+	}
+	return json.Unmarshal(data, order)
+}
+
+// SetCompression compresses cars and payload chunks of at least threshold bytes from now on;
+// 0 turns compression off. Values already on the ledger are read in either format.
+func (c *CarContract) SetCompression(ctx contractapi.TransactionContextInterface, threshold int) (string, error) {
+	err := checkAccess(ctx, "CarContract:SetCompression")
+	if err != nil {
+		return "", err
+	}
+
+	if threshold < 0 {
+		return "", errorf(CodeInvalidArgument, "the compression threshold cannot be negative")
+	}
+
+	config, err := readConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	config.CompressionThreshold = threshold
+	err = writeConfig(ctx, config)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("compression threshold set to %v bytes", threshold), nil
+}
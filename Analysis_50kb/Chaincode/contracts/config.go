@@ -0,0 +1,76 @@
+package contracts
+
+import (
+	"encoding/json"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+const configKeyPrefix string = "config"
+
+// ChaincodeConfig holds the chaincode settings stored on the ledger
+type ChaincodeConfig struct {
+	AssetType            string         `json:"assetType"`
+	StrictVIN            bool           `json:"strictVin"`
+	CompressionThreshold int            `json:"compressionThreshold"`
+	StateEncoding        string         `json:"stateEncoding"`
+	AutoMatch            bool           `json:"autoMatch"`
+	DealerPriorities     map[string]int `json:"dealerPriorities,omitempty" metadata:",optional"`
+	OrderRetentionDays   int            `json:"orderRetentionDays"`
+}
+
+func configKey(ctx contractapi.TransactionContextInterface) (string, error) {
+	key, err := ctx.GetStub().CreateCompositeKey(configKeyPrefix, []string{"chaincode"})
+	if err != nil {
+		return "", errorf(CodeInternal, "could not create the config key. %s", err)
+	}
+	return key, nil
+}
+
+// readConfig returns the stored settings, or the defaults when none have been stored
+func readConfig(ctx contractapi.TransactionContextInterface) (*ChaincodeConfig, error) {
+	key, err := configKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bytes, err := ctx.GetStub().GetState(key)
+	if err != nil {
+		return nil, errorf(CodeInternal, "failed to read from world state: %v", err)
+	}
+
+	config := ChaincodeConfig{AssetType: "config", StateEncoding: StateEncodingJSON, OrderRetentionDays: defaultOrderRetentionDays}
+	if len(bytes) == 0 {
+		return &config, nil
+	}
+
+	err = json.Unmarshal(bytes, &config)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not unmarshal world state data to type ChaincodeConfig")
+	}
+	return &config, nil
+}
+
+func writeConfig(ctx contractapi.TransactionContextInterface, config *ChaincodeConfig) error {
+	key, err := configKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	bytes, _ := json.Marshal(config)
+	err = ctx.GetStub().PutState(key, bytes)
+	if err != nil {
+		return errorf(CodeInternal, "could not write the config. %s", err)
+	}
+	return nil
+}
+
+// GetChaincodeConfig returns the chaincode settings in force
+func (c *CarContract) GetChaincodeConfig(ctx contractapi.TransactionContextInterface) (*ChaincodeConfig, error) {
+	err := checkAccess(ctx, "CarContract:GetChaincodeConfig")
+	if err != nil {
+		return nil, err
+	}
+
+	return readConfig(ctx)
+}
@@ -0,0 +1,444 @@
+package contracts
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"kbaauto/query"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+const (
+	orderBookPrefix     string = "orderbook"
+	matchDecisionPrefix string = "matchdecision"
+)
+
+// Ways a car gets matched to an order
+const (
+	MatchManual = "manual"
+	MatchAuto   = "auto"
+	MatchBatch  = "batch"
+)
+
+// MatchDecision records which order a car was matched to and why. Decisions are kept in the
+// order collection next to the orders they refer to.
+type MatchDecision struct {
+	AssetType      string    `json:"assetType"`
+	CarId          string    `json:"carId"`
+	OrderID        string    `json:"orderID"`
+	Mode           string    `json:"mode"`
+	Candidates     int32     `json:"candidates"`
+	Priority       int       `json:"priority"`
+	OrderCreatedAt string    `json:"orderCreatedAt,omitempty" metadata:",optional"`
+	DecidedBy      *Identity `json:"decidedBy"`
+	DecidedAt      string    `json:"decidedAt"`
+	TxId           string    `json:"txId"`
+}
+
+// MatchingResult reports one RunMatching batch
+type MatchingResult struct {
+	Scanned int32            `json:"scanned"`
+	Matched int32            `json:"matched"`
+	Matches []*MatchDecision `json:"matches"`
+}
+
+// orderBookKey indexes an open order by make, model and color, then by creation time so that a
+// partial key lookup returns the compatible orders oldest first
+func orderBookKey(ctx contractapi.TransactionContextInterface, order *Order) (string, error) {
+	key, err := ctx.GetStub().CreateCompositeKey(orderBookPrefix, []string{order.Make, order.Model, order.Color, order.CreatedAt, order.OrderID})
+	if err != nil {
+		return "", errorf(CodeInternal, "could not create the order book key. %s", err)
+	}
+	return key, nil
+}
+
+func indexOrder(ctx contractapi.TransactionContextInterface, order *Order) error {
+	key, err := orderBookKey(ctx, order)
+	if err != nil {
+		return err
+	}
+
+	err = ctx.GetStub().PutPrivateData(collectionName, key, []byte{0x00})
+	if err != nil {
+		return errorf(CodeInternal, "could not add the order %s to the order book. %s", order.OrderID, err)
+	}
+	return nil
+}
+
+func unindexOrder(ctx contractapi.TransactionContextInterface, order *Order) error {
+	key, err := orderBookKey(ctx, order)
+	if err != nil {
+		return err
+	}
+
+	err = ctx.GetStub().DelPrivateData(collectionName, key)
+	if err != nil {
+		return errorf(CodeInternal, "could not remove the order %s from the order book. %s", order.OrderID, err)
+	}
+	return nil
+}
+
+// findOrder returns the open order a car should go to: the oldest compatible order of the dealer
+// with the highest priority. It returns nil when no order is compatible. A transaction does not
+// read its own writes, so the orders it has matched cars to already are passed in and used instead.
+func findOrder(ctx contractapi.TransactionContextInterface, car *Car, now time.Time, priorities map[string]int, matched map[string]*Order) (*Order, int32, error) {
+	resultsIterator, err := ctx.GetStub().GetPrivateDataByPartialCompositeKey(collectionName, orderBookPrefix, []string{car.Make, car.Model, car.Color})
+	if err != nil {
+		return nil, 0, errorf(CodeInternal, "could not read the order book. %s", err)
+	}
+	defer resultsIterator.Close()
+
+	var best *Order
+	var candidates int32
+	for resultsIterator.HasNext() {
+		queryResult, err := resultsIterator.Next()
+		if err != nil {
+			return nil, 0, errorf(CodeInternal, "could not fetch the details of the result iterator. %s", err)
+		}
+
+		_, attributes, err := ctx.GetStub().SplitCompositeKey(queryResult.Key)
+		if err != nil || len(attributes) != 5 {
+			return nil, 0, errorf(CodeInternal, "could not split the order book key %s", queryResult.Key)
+		}
+
+		order, ok := matched[attributes[4]]
+		if !ok {
+			order, err = readOrder(ctx, attributes[4])
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+		if order.Status != OrderOpen || order.expired(now) {
+			continue
+		}
+
+		candidates++
+		if best == nil || orderPriority(priorities, order) > orderPriority(priorities, best) {
+			best = order
+		}
+	}
+	return best, candidates, nil
+}
+
+// orderPriority returns the priority of the dealer who placed the order. Orders placed before their
+// creator was recorded have no priority.
+func orderPriority(priorities map[string]int, order *Order) int {
+	if order.CreatedBy == nil {
+		return 0
+	}
+	return priorities[order.CreatedBy.String()]
+}
+
+// matchCar assigns the car to the dealer of the order, who becomes its owner when the order records
+// its creator, and records the decision. The order is closed
+// as Matched once it has all the cars it is for. The caller emits the event.
+func (c *CarContract) matchCar(ctx contractapi.TransactionContextInterface, car *Car, order *Order, mode string, candidates int32, priority int) (*MatchDecision, error) {
+	if car.Make != order.Make || car.Color != order.Color || car.Model != order.Model {
+		return nil, errorf(CodeConflict, "order is not matching")
+	}
+	if order.Status != OrderOpen {
+		return nil, errorf(CodeConflict, "the order %s cannot move from %s to %s", order.OrderID, order.Status, OrderMatched)
+	}
+
+	err := transitionCar(car, StatusAtDealer)
+	if err != nil {
+		return nil, err
+	}
+	car.OwnedBy = order.DealerName
+	if order.CreatedBy != nil {
+		car.Owner = order.CreatedBy
+	}
+
+	car.Version++
+	bytes, err := marshalCar(ctx, car)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.GetStub().PutState(car.CarId, bytes)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not add the data %s", err)
+	}
+
+	order.CarIds = append(order.matchedCars(), car.CarId)
+	order.CarId = car.CarId
+	if len(order.CarIds) >= order.quantity() {
+		err = transitionOrder(ctx, order, OrderMatched)
+	} else {
+		err = writeOrder(ctx, order)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = applyEndorsementTemplate(ctx, car)
+	if err != nil {
+		return nil, err
+	}
+
+	decidedBy, err := clientIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now, err := txTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	decision := &MatchDecision{
+		AssetType:      "matchDecision",
+		CarId:          car.CarId,
+		OrderID:        order.OrderID,
+		Mode:           mode,
+		Candidates:     candidates,
+		Priority:       priority,
+		OrderCreatedAt: order.CreatedAt,
+		DecidedBy:      &decidedBy,
+		DecidedAt:      now.Format(time.RFC3339),
+		TxId:           ctx.GetStub().GetTxID(),
+	}
+	err = recordMatchDecision(ctx, decision)
+	if err != nil {
+		return nil, err
+	}
+	return decision, nil
+}
+
+// autoMatch matches a car to the order picked by findOrder and keeps the updated order in matched.
+// It returns nil when no order is compatible.
+func (c *CarContract) autoMatch(ctx contractapi.TransactionContextInterface, car *Car, mode string, config *ChaincodeConfig, matched map[string]*Order) (*MatchDecision, error) {
+	now, err := txTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, candidates, err := findOrder(ctx, car, now, config.DealerPriorities, matched)
+	if err != nil || order == nil {
+		return nil, err
+	}
+
+	decision, err := c.matchCar(ctx, car, order, mode, candidates, orderPriority(config.DealerPriorities, order))
+	if err != nil {
+		return nil, err
+	}
+	matched[order.OrderID] = order
+	return decision, nil
+}
+
+func matchDecisionKey(ctx contractapi.TransactionContextInterface, carID string, orderID string) (string, error) {
+	key, err := ctx.GetStub().CreateCompositeKey(matchDecisionPrefix, []string{carID, orderID})
+	if err != nil {
+		return "", errorf(CodeInternal, "could not create the match decision key. %s", err)
+	}
+	return key, nil
+}
+
+func recordMatchDecision(ctx contractapi.TransactionContextInterface, decision *MatchDecision) error {
+	key, err := matchDecisionKey(ctx, decision.CarId, decision.OrderID)
+	if err != nil {
+		return err
+	}
+
+	bytes, _ := json.Marshal(decision)
+	err = ctx.GetStub().PutPrivateData(collectionName, key, bytes)
+	if err != nil {
+		return errorf(CodeInternal, "could not record the match decision. %s", err)
+	}
+	return nil
+}
+
+// RunMatching matches up to limit manufactured cars against the order book and emits one
+// OrdersMatched event for the batch. Cars without a compatible open order are left alone and not recorded.
+func (c *CarContract) RunMatching(ctx contractapi.TransactionContextInterface, limit int32) (*MatchingResult, error) {
+	err := checkAccess(ctx, "CarContract:RunMatching")
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 || limit > maxCarPageSize {
+		return nil, errorf(CodeInvalidArgument, "the matching limit must be between 1 and %d", maxCarPageSize)
+	}
+
+	config, err := readConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queryString, err := query.New().
+		Eq("assetType", "car").
+		Eq("status", string(StatusManufactured)).
+		Exists("archive", false).
+		String()
+	if err != nil {
+		return nil, errorf(CodeInternal, "%s", err)
+	}
+
+	resultsIterator, err := ctx.GetStub().GetQueryResult(queryString)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not get the car records. %s", err)
+	}
+	defer resultsIterator.Close()
+
+	result := &MatchingResult{Matches: []*MatchDecision{}}
+	matched := map[string]*Order{}
+	for resultsIterator.HasNext() && result.Scanned < limit {
+		queryResult, err := resultsIterator.Next()
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not fetch the details of the result iterator. %s", err)
+		}
+
+		var car Car
+		err = unmarshalCar(queryResult.Value, &car)
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not unmarshal world state data to type Car")
+		}
+		if car.Status != StatusManufactured || car.Archive != nil {
+			continue
+		}
+		result.Scanned++
+
+		decision, err := c.autoMatch(ctx, &car, MatchBatch, config, matched)
+		if err != nil {
+			return nil, err
+		}
+		if decision != nil {
+			result.Matched++
+			result.Matches = append(result.Matches, decision)
+		}
+	}
+
+	if result.Matched > 0 {
+		err = emitMatchingEvent(ctx, result.Matches)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// GetMatchDecisions returns the recorded match decisions of a car, or of every car when carID is empty
+func (c *CarContract) GetMatchDecisions(ctx contractapi.TransactionContextInterface, carID string) ([]*MatchDecision, error) {
+	err := checkAccess(ctx, "CarContract:GetMatchDecisions")
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := []string{}
+	if carID != "" {
+		attributes = append(attributes, carID)
+	}
+	resultsIterator, err := ctx.GetStub().GetPrivateDataByPartialCompositeKey(collectionName, matchDecisionPrefix, attributes)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not get the match decisions. %s", err)
+	}
+	defer resultsIterator.Close()
+
+	decisions := []*MatchDecision{}
+	for resultsIterator.HasNext() {
+		queryResult, err := resultsIterator.Next()
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not fetch the details of the result iterator. %s", err)
+		}
+
+		var decision MatchDecision
+		err = json.Unmarshal(queryResult.Value, &decision)
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not unmarshal private data collection data to type MatchDecision")
+		}
+		decisions = append(decisions, &decision)
+	}
+	return decisions, nil
+}
+
+// SetAutoMatch turns matching inside CreateCar on or off. When on, a new car goes straight to
+// the dealer of the order picked from the order book.
+func (c *CarContract) SetAutoMatch(ctx contractapi.TransactionContextInterface, enabled bool) (string, error) {
+	err := checkAccess(ctx, "CarContract:SetAutoMatch")
+	if err != nil {
+		return "", err
+	}
+
+	config, err := readConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	config.AutoMatch = enabled
+	err = writeConfig(ctx, config)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("auto matching set to %v", enabled), nil
+}
+
+// SetDealerPriority sets the weight in the order book of the orders placed by a dealer identity.
+// Orders of the dealer with the highest priority are matched first, orders of equal priority oldest
+// first. A priority of 0 removes the dealer's weight.
+func (c *CarContract) SetDealerPriority(ctx contractapi.TransactionContextInterface, dealerMSPID string, dealerEnrollmentID string, priority int) (string, error) {
+	err := checkAccess(ctx, "CarContract:SetDealerPriority")
+	if err != nil {
+		return "", err
+	}
+
+	if dealerMSPID == "" || dealerEnrollmentID == "" {
+		return "", errorf(CodeInvalidArgument, "the dealer MSP ID and enrollment ID are required")
+	}
+	if priority < 0 {
+		return "", errorf(CodeInvalidArgument, "the priority must not be negative")
+	}
+
+	config, err := readConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	dealer := Identity{MSPID: dealerMSPID, EnrollmentID: dealerEnrollmentID}.String()
+	if priority == 0 {
+		delete(config.DealerPriorities, dealer)
+	} else {
+		if config.DealerPriorities == nil {
+			config.DealerPriorities = map[string]int{}
+		}
+		config.DealerPriorities[dealer] = priority
+	}
+
+	err = writeConfig(ctx, config)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("priority of dealer %v set to %v", dealer, priority), nil
+}
+
+// RebuildOrderBook adds up to limit open orders from startKey on to the order book. Orders
+// placed before the order book existed are matched automatically once they are indexed.
+func (o *OrderContract) RebuildOrderBook(ctx contractapi.TransactionContextInterface, startKey string, endKey string, limit int32) (*MigrationResult, error) {
+	err := checkAccess(ctx, "OrderContract:RebuildOrderBook")
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkMigrationLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	resultsIterator, err := ctx.GetStub().GetPrivateDataByRange(collectionName, startKey, endKey)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not fetch the private data by range. %s", err)
+	}
+	defer resultsIterator.Close()
+
+	return migrateRange(resultsIterator, limit, func(key string, value []byte) (bool, error) {
+		var order Order
+		err := unmarshalOrder(value, &order)
+		if err != nil {
+			return false, errorf(CodeInternal, "could not unmarshal the order %s. %s", key, err)
+		}
+		if order.Status != "" && order.Status != OrderOpen {
+			return false, nil
+		}
+		return true, indexOrder(ctx, &order)
+	})
+}
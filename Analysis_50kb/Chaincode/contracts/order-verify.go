@@ -0,0 +1,142 @@
+package contracts
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+// transientOrderKey is the transient map key of a claimed order
+const transientOrderKey string = "order"
+
+// OrderVerification is the result of checking a claimed order against the private data hash on the ledger
+type OrderVerification struct {
+	OrderID    string `json:"orderID"`
+	Verified   bool   `json:"verified"`
+	Encoding   string `json:"encoding,omitempty" metadata:",optional"`
+	LedgerHash string `json:"ledgerHash"`
+}
+
+// orderValue is a value an order may be stored as in the collection
+type orderValue struct {
+	encoding string
+	data     []byte
+}
+
+// legacyOrder is the form of the orders written before orders had a status
+type legacyOrder struct {
+	AssetType  string `json:"assetType"`
+	Color      string `json:"color"`
+	DealerName string `json:"dealerName"`
+	Make       string `json:"make"`
+	Model      string `json:"model"`
+	OrderID    string `json:"orderID"`
+}
+
+// canonicalOrderValues returns the exact values marshalOrder writes for an order in each state encoding.
+// An open order with only the original fields may also be stored in its legacy form, which ReadOrder
+// shows as Open.
+func canonicalOrderValues(order *Order) ([]orderValue, error) {
+	var values []orderValue
+	for _, encoding := range []string{StateEncodingJSON, StateEncodingProtobuf} {
+		serializer, err := SerializerFor(encoding)
+		if err != nil {
+			return nil, err
+		}
+		data, err := serializer.MarshalOrder(order)
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not marshal the order. %s", err)
+		}
+
+		if encoding == StateEncodingProtobuf {
+			data, err = encodeProtobuf(data, 0)
+			if err != nil {
+				return nil, err
+			}
+		}
+		values = append(values, orderValue{encoding: encoding, data: data})
+	}
+
+	legacy := legacyOrder{
+		AssetType:  order.AssetType,
+		Color:      order.Color,
+		DealerName: order.DealerName,
+		Make:       order.Make,
+		Model:      order.Model,
+		OrderID:    order.OrderID,
+	}
+	original := Order{
+		AssetType:  order.AssetType,
+		Color:      order.Color,
+		DealerName: order.DealerName,
+		Make:       order.Make,
+		Model:      order.Model,
+		OrderID:    order.OrderID,
+		Status:     order.Status,
+	}
+	if (order.Status == OrderOpen || order.Status == "") && reflect.DeepEqual(*order, original) {
+		data, _ := json.Marshal(legacy)
+		values = append(values, orderValue{encoding: StateEncodingJSON, data: data})
+	}
+	return values, nil
+}
+
+// VerifyOrder checks the order passed as JSON under the "order" key of the transient map against
+// the hash of the order in the collection, so organisations outside the collection can confirm
+// what a dealer shows them. The order must be complete, as returned by ReadOrder; it is verified
+// in every state encoding, and the matching one is reported.
+func (o *OrderContract) VerifyOrder(ctx contractapi.TransactionContextInterface, orderID string) (*OrderVerification, error) {
+	err := checkAccess(ctx, "OrderContract:VerifyOrder")
+	if err != nil {
+		return nil, err
+	}
+
+	transientData, err := ctx.GetStub().GetTransient()
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not fetch transient data. %s", err)
+	}
+
+	claimed, ok := transientData[transientOrderKey]
+	if !ok {
+		return nil, errorf(CodeInvalidArgument, "the order was not specified in transient data. Please try again")
+	}
+
+	var order Order
+	decoder := json.NewDecoder(bytes.NewReader(claimed))
+	decoder.DisallowUnknownFields()
+	err = decoder.Decode(&order)
+	if err != nil {
+		return nil, errorf(CodeInvalidArgument, "could not parse the order. %s", err)
+	}
+	if order.OrderID != orderID {
+		return nil, errorf(CodeInvalidArgument, "the order in transient data has the ID %s, expected %s", order.OrderID, orderID)
+	}
+
+	hash, err := ctx.GetStub().GetPrivateDataHash(collectionName, orderID)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not fetch the private data hash. %s", err)
+	}
+	if hash == nil {
+		return nil, errorf(CodeNotFound, "the asset %s does not exist", orderID)
+	}
+
+	values, err := canonicalOrderValues(&order)
+	if err != nil {
+		return nil, err
+	}
+
+	verification := &OrderVerification{OrderID: orderID, LedgerHash: hex.EncodeToString(hash)}
+	for _, value := range values {
+		sum := sha256.Sum256(value.data)
+		if bytes.Equal(sum[:], hash) {
+			verification.Verified = true
+			verification.Encoding = value.encoding
+			break
+		}
+	}
+	return verification, nil
+}
@@ -0,0 +1,50 @@
+package contracts
+
+import (
+	"encoding/json"
+)
+
+// State encodings that cars and orders can be stored in
+const (
+	StateEncodingJSON     string = "json"
+	StateEncodingProtobuf string = "protobuf"
+)
+
+// StateSerializer converts cars and orders to and from the bytes stored on the ledger. Encoded
+// values carry no header byte; marshalCar and marshalOrder add it.
+type StateSerializer interface {
+	MarshalCar(car *Car) ([]byte, error)
+	UnmarshalCar(data []byte, car *Car) error
+	MarshalOrder(order *Order) ([]byte, error)
+	UnmarshalOrder(data []byte, order *Order) error
+}
+
+// SerializerFor returns the serializer of a state encoding; an empty encoding means JSON
+func SerializerFor(encoding string) (StateSerializer, error) {
+	switch encoding {
+	case "", StateEncodingJSON:
+		return jsonSerializer{}, nil
+	case StateEncodingProtobuf:
+		return protobufSerializer{}, nil
+	default:
+		return nil, errorf(CodeInvalidArgument, "unknown state encoding %s, expected %s or %s", encoding, StateEncodingJSON, StateEncodingProtobuf)
+	}
+}
+
+type jsonSerializer struct{}
+
+func (jsonSerializer) MarshalCar(car *Car) ([]byte, error) {
+	return json.Marshal(car)
+}
+
+func (jsonSerializer) UnmarshalCar(data []byte, car *Car) error {
+	return json.Unmarshal(data, car)
+}
+
+func (jsonSerializer) MarshalOrder(order *Order) ([]byte, error) {
+	return json.Marshal(order)
+}
+
+func (jsonSerializer) UnmarshalOrder(data []byte, order *Order) error {
+	return json.Unmarshal(data, order)
+}
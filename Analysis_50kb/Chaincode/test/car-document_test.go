@@ -0,0 +1,50 @@
+package chaincodetest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"kbaauto/contracts"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachDocument(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("DealerMSP")
+	worldState := prepWorldState(chaincodeStub)
+	setClient(transactionContext, "DealerMSP", "dealer1")
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	bytes, err := json.Marshal(&contracts.Car{CarId: "car1", Status: contracts.StatusAtDealer, Version: 1})
+	require.NoError(t, err)
+	worldState["car1"] = bytes
+
+	content := []byte("%PDF-1.7 invoice")
+	sum := sha256.Sum256(content)
+	hash := hex.EncodeToString(sum[:])
+
+	// Assert the document is recorded with its uploader
+	_, err = carAsset.AttachDocument(transactionContext, "car1", "invoice.pdf", hash, "application/pdf", int64(len(content)))
+	require.NoError(t, err)
+
+	car, err := carAsset.ReadCar(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Len(t, car.Documents, 1)
+	require.Equal(t, hash, car.Documents[0].SHA256)
+	require.Equal(t, int64(len(content)), car.Documents[0].Size)
+	require.Equal(t, "DealerMSP/dealer1", car.Documents[0].UploadedBy.String())
+	require.Equal(t, int64(2), car.Version)
+
+	// Assert the same content cannot be attached twice
+	_, err = carAsset.AttachDocument(transactionContext, "car1", "copy.pdf", hash, "application/pdf", int64(len(content)))
+	requireError(t, err, contracts.CodeAlreadyExists, "the document "+hash+" is already attached to the car car1")
+
+	// Assert malformed hashes and media types are rejected
+	_, err = carAsset.AttachDocument(transactionContext, "car1", "invoice.pdf", "abc", "pdf", 10)
+	requireError(t, err, contracts.CodeInvalidArgument, "invalid document. sha256 must have exactly 64 characters; mediaType must be a media type such as application/pdf")
+}
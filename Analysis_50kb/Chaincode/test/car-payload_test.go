@@ -0,0 +1,75 @@
+package chaincodetest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"kbaauto/contracts"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCarPayload(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("ManufacturerMSP")
+	worldState := prepWorldState(chaincodeStub)
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	bytes, err := json.Marshal(&contracts.Car{CarId: "car1", Status: contracts.StatusManufactured})
+	require.NoError(t, err)
+	worldState["car1"] = bytes
+
+	payload := strings.Repeat("0123456789", 2000)
+
+	// Assert a chunked payload is split into 8 KB keys and reassembled
+	result, err := carAsset.PutCarPayload(transactionContext, "car1", "brochure", payload, true)
+	require.NoError(t, err)
+	require.Equal(t, "payload brochure of car car1 stored in 3 chunks", result)
+
+	manifest, err := carAsset.GetCarPayloadManifest(transactionContext, "car1", "brochure")
+	require.NoError(t, err)
+	require.Equal(t, 20000, manifest.Size)
+	require.Equal(t, 8192, manifest.ChunkSize)
+
+	stored, err := carAsset.GetCarPayload(transactionContext, "car1", "brochure")
+	require.NoError(t, err)
+	require.Equal(t, payload, stored)
+
+	// Assert a single key write replaces the chunks
+	_, err = carAsset.PutCarPayload(transactionContext, "car1", "brochure", payload, false)
+	require.NoError(t, err)
+	require.Len(t, worldState, 3)
+
+	stored, err = carAsset.GetCarPayload(transactionContext, "car1", "brochure")
+	require.NoError(t, err)
+	require.Equal(t, payload, stored)
+
+	// Assert a tampered chunk is detected
+	for key := range worldState {
+		if strings.Contains(key, "payloadchunk") {
+			worldState[key] = []byte("tampered")
+		}
+	}
+	_, err = carAsset.GetCarPayload(transactionContext, "car1", "brochure")
+	requireError(t, err, contracts.CodeInternal, "the payload brochure of car car1 does not match its manifest")
+
+	_, err = carAsset.GetCarPayload(transactionContext, "car1", "manual")
+	requireError(t, err, contracts.CodeNotFound, "the car car1 has no payload manual")
+
+	// Assert a legacy chunk is returned as stored even when it starts with a header byte
+	legacy := "\x01legacy payload"
+	sum := sha256.Sum256([]byte(legacy))
+	bytes, err = json.Marshal(&contracts.PayloadManifest{CarId: "car1", Name: "legacy", Size: len(legacy), ChunkSize: len(legacy), Chunks: 1, SHA256: hex.EncodeToString(sum[:])})
+	require.NoError(t, err)
+	worldState["\x00payload\x00car1\x00legacy\x00"] = bytes
+	worldState["\x00payloadchunk\x00car1\x00legacy\x00000000\x00"] = []byte(legacy)
+
+	stored, err = carAsset.GetCarPayload(transactionContext, "car1", "legacy")
+	require.NoError(t, err)
+	require.Equal(t, legacy, stored)
+}
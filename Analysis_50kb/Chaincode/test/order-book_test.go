@@ -0,0 +1,287 @@
+package chaincodetest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"kbaauto/contracts"
+
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestOrderBook(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("ManufacturerMSP")
+	worldState := prepWorldState(chaincodeStub)
+	prepPrivateData(chaincodeStub)
+	chaincodeStub.GetTxIDReturns("tx1")
+
+	// Create contract instances
+	carAsset := contracts.CarContract{}
+	orderAsset := contracts.OrderContract{}
+
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000}, nil)
+	createOrder(t, transactionContext, chaincodeStub, "order1", "KBA3")
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000060}, nil)
+	createOrderAs(t, transactionContext, chaincodeStub, "dealer2", "order2", "KBA4")
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000120}, nil)
+	createOrder(t, transactionContext, chaincodeStub, "order3", "KBA3")
+
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+	setAdmin(transactionContext, "user1")
+
+	// Assert a new car is not matched unless auto matching is on
+	_, err := carAsset.CreateCar(transactionContext, "car1", "Tata", "Nexon", "White", "Factory-01", "2024-01-01")
+	require.NoError(t, err)
+	car, err := carAsset.ReadCar(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Equal(t, contracts.StatusManufactured, car.Status)
+
+	// Assert auto matching gives a new car the oldest compatible order
+	_, err = carAsset.SetAutoMatch(transactionContext, true)
+	require.NoError(t, err)
+
+	_, err = carAsset.CreateCar(transactionContext, "car2", "Tata", "Nexon", "White", "Factory-01", "2024-01-01")
+	require.NoError(t, err)
+	car, err = carAsset.ReadCar(transactionContext, "car2")
+	require.NoError(t, err)
+	require.Equal(t, contracts.StatusAtDealer, car.Status)
+	require.Equal(t, "KBA3", car.OwnedBy)
+
+	name, payload := chaincodeStub.SetEventArgsForCall(chaincodeStub.SetEventCallCount() - 1)
+	require.Equal(t, contracts.EventCarCreated, name)
+	var carEvent contracts.CarEvent
+	require.NoError(t, json.Unmarshal(payload, &carEvent))
+	require.Equal(t, "order1", carEvent.OrderID)
+
+	order, err := orderAsset.ReadOrder(transactionContext, "order1")
+	require.NoError(t, err)
+	require.Equal(t, contracts.OrderMatched, order.Status)
+	require.Equal(t, "car2", order.CarId)
+
+	_, err = carAsset.CreateCar(transactionContext, "car3", "Tata", "Nexon", "Black", "Factory-01", "2024-01-01")
+	require.NoError(t, err)
+	car, err = carAsset.ReadCar(transactionContext, "car3")
+	require.NoError(t, err)
+	require.Equal(t, contracts.StatusManufactured, car.Status)
+
+	// Assert the priority of the dealer who placed the order comes before order age
+	message, err := carAsset.SetDealerPriority(transactionContext, "DealerMSP", "dealer1", 5)
+	require.NoError(t, err)
+	require.Equal(t, "priority of dealer DealerMSP/dealer1 set to 5", message)
+
+	_, err = carAsset.SetDealerPriority(transactionContext, "DealerMSP", "dealer1", -1)
+	requireError(t, err, contracts.CodeInvalidArgument, "the priority must not be negative")
+
+	_, err = carAsset.SetDealerPriority(transactionContext, "DealerMSP", "", 5)
+	requireError(t, err, contracts.CodeInvalidArgument, "the dealer MSP ID and enrollment ID are required")
+
+	chaincodeStub.GetQueryResultCalls(func(query string) (shim.StateQueryIteratorInterface, error) {
+		return newStateIterator(worldState, "", ""), nil
+	})
+	result, err := carAsset.RunMatching(transactionContext, 10)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), result.Scanned)
+	require.Equal(t, int32(1), result.Matched)
+	require.Equal(t, "car1", result.Matches[0].CarId)
+	require.Equal(t, "order3", result.Matches[0].OrderID)
+	require.Equal(t, contracts.MatchBatch, result.Matches[0].Mode)
+	require.Equal(t, int32(2), result.Matches[0].Candidates)
+	require.Equal(t, 5, result.Matches[0].Priority)
+
+	// Assert the batch is published as one event
+	name, payload = chaincodeStub.SetEventArgsForCall(chaincodeStub.SetEventCallCount() - 1)
+	require.Equal(t, contracts.EventOrdersMatched, name)
+	var matchingEvent contracts.MatchingEvent
+	require.NoError(t, json.Unmarshal(payload, &matchingEvent))
+	require.Equal(t, []*contracts.OrderMatch{{OrderID: "order3", CarId: "car1"}}, matchingEvent.Matches)
+
+	_, err = carAsset.RunMatching(transactionContext, 0)
+	requireError(t, err, contracts.CodeInvalidArgument, "the matching limit must be between 1 and 200")
+
+	// Assert every match is recorded
+	decisions, err := carAsset.GetMatchDecisions(transactionContext, "")
+	require.NoError(t, err)
+	require.Len(t, decisions, 2)
+
+	decisions, err = carAsset.GetMatchDecisions(transactionContext, "car2")
+	require.NoError(t, err)
+	require.Len(t, decisions, 1)
+	require.Equal(t, &contracts.MatchDecision{
+		AssetType:      "matchDecision",
+		CarId:          "car2",
+		OrderID:        "order1",
+		Mode:           contracts.MatchAuto,
+		Candidates:     3,
+		OrderCreatedAt: "2023-11-14T22:13:20Z",
+		DecidedBy:      &contracts.Identity{MSPID: "ManufacturerMSP", EnrollmentID: "user1"},
+		DecidedAt:      "2023-11-14T22:15:20Z",
+		TxId:           "tx1",
+	}, decisions[0])
+}
+
+func TestRunMatchingMatchesAnOrderOnce(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("ManufacturerMSP")
+	worldState := prepWorldState(chaincodeStub)
+	prepPrivateData(chaincodeStub)
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000}, nil)
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	createOrder(t, transactionContext, chaincodeStub, "order1", "KBA3")
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+	setAdmin(transactionContext, "user1")
+
+	for _, carID := range []string{"car1", "car2"} {
+		bytes, err := json.Marshal(&contracts.Car{AssetType: "car", CarId: carID, Make: "Tata", Model: "Nexon", Color: "White", Status: contracts.StatusManufactured})
+		require.NoError(t, err)
+		worldState[carID] = bytes
+	}
+	chaincodeStub.GetQueryResultCalls(func(query string) (shim.StateQueryIteratorInterface, error) {
+		return newStateIterator(worldState, "", ""), nil
+	})
+
+	// Like a peer, the collection does not show the transaction its own writes
+	chaincodeStub.PutPrivateDataCalls(func(collection string, key string, value []byte) error {
+		return nil
+	})
+	chaincodeStub.DelPrivateDataCalls(func(collection string, key string) error {
+		return nil
+	})
+
+	// Assert two compatible cars do not both get the single order
+	result, err := carAsset.RunMatching(transactionContext, 10)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), result.Scanned)
+	require.Equal(t, int32(1), result.Matched)
+	require.Equal(t, "car1", result.Matches[0].CarId)
+
+	car, err := carAsset.ReadCar(transactionContext, "car2")
+	require.NoError(t, err)
+	require.Equal(t, contracts.StatusManufactured, car.Status)
+}
+
+func TestOrderQuantity(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("ManufacturerMSP")
+	worldState := prepWorldState(chaincodeStub)
+	privateData := prepPrivateData(chaincodeStub)
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000}, nil)
+
+	// Create contract instances
+	carAsset := contracts.CarContract{}
+	orderAsset := contracts.OrderContract{}
+
+	setClient(transactionContext, "DealerMSP", "dealer1")
+	for _, orderID := range []string{"order1", "order2"} {
+		chaincodeStub.GetTransientReturns(map[string][]byte{
+			"order": []byte(`{"make":"Tata","model":"Nexon","color":"White","dealerName":"KBA3","quantity":2}`),
+		}, nil)
+		_, err := orderAsset.CreateOrder(transactionContext, orderID)
+		require.NoError(t, err)
+	}
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+	setAdmin(transactionContext, "user1")
+
+	for _, carID := range []string{"car1", "car2", "car3", "car4"} {
+		bytes, err := json.Marshal(&contracts.Car{AssetType: "car", CarId: carID, Make: "Tata", Model: "Nexon", Color: "White", Status: contracts.StatusManufactured})
+		require.NoError(t, err)
+		worldState[carID] = bytes
+	}
+
+	// Assert an order stays open until it has all its cars
+	_, err := carAsset.MatchOrder(transactionContext, "car1", "order1")
+	require.NoError(t, err)
+	order, err := orderAsset.ReadOrder(transactionContext, "order1")
+	require.NoError(t, err)
+	require.Equal(t, contracts.OrderOpen, order.Status)
+	require.Equal(t, []string{"car1"}, order.CarIds)
+
+	_, err = carAsset.MatchOrder(transactionContext, "car2", "order1")
+	require.NoError(t, err)
+	order, err = orderAsset.ReadOrder(transactionContext, "order1")
+	require.NoError(t, err)
+	require.Equal(t, contracts.OrderMatched, order.Status)
+	require.Equal(t, []string{"car1", "car2"}, order.CarIds)
+
+	_, err = carAsset.MatchOrder(transactionContext, "car3", "order1")
+	requireError(t, err, contracts.CodeConflict, "the order order1 cannot move from Matched to Matched")
+
+	// Assert a batch fills an order from several cars without reading its own writes
+	delete(worldState, "car1")
+	delete(worldState, "car2")
+	chaincodeStub.GetQueryResultCalls(func(query string) (shim.StateQueryIteratorInterface, error) {
+		return newStateIterator(worldState, "", ""), nil
+	})
+	written := map[string][]byte{}
+	chaincodeStub.PutPrivateDataCalls(func(collection string, key string, value []byte) error {
+		written[key] = value
+		return nil
+	})
+	chaincodeStub.DelPrivateDataCalls(func(collection string, key string) error {
+		return nil
+	})
+
+	result, err := carAsset.RunMatching(transactionContext, 10)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), result.Matched)
+	require.Equal(t, "order2", result.Matches[0].OrderID)
+	require.Equal(t, "order2", result.Matches[1].OrderID)
+
+	privateData["order2"] = written["order2"]
+	order, err = orderAsset.ReadOrder(transactionContext, "order2")
+	require.NoError(t, err)
+	require.Equal(t, contracts.OrderMatched, order.Status)
+	require.Equal(t, []string{"car3", "car4"}, order.CarIds)
+}
+
+func TestRebuildOrderBook(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("ManufacturerMSP")
+	worldState := prepWorldState(chaincodeStub)
+	privateData := prepPrivateData(chaincodeStub)
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000}, nil)
+	chaincodeStub.GetPrivateDataByRangeCalls(func(collection string, startKey string, endKey string) (shim.StateQueryIteratorInterface, error) {
+		return newStateIterator(privateData, startKey, endKey), nil
+	})
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+	setAdmin(transactionContext, "user1")
+
+	// Create contract instances
+	carAsset := contracts.CarContract{}
+	orderAsset := contracts.OrderContract{}
+
+	// Orders placed before the order book existed
+	for _, order := range []*contracts.Order{
+		{AssetType: "Order", OrderID: "order1", Make: "Tata", Model: "Nexon", Color: "White", DealerName: "KBA3"},
+		{AssetType: "Order", OrderID: "order2", Make: "Tata", Model: "Nexon", Color: "White", DealerName: "KBA3", Status: contracts.OrderCancelled},
+	} {
+		bytes, err := json.Marshal(order)
+		require.NoError(t, err)
+		privateData[order.OrderID] = bytes
+	}
+	bytes, err := json.Marshal(&contracts.Car{AssetType: "car", CarId: "car1", Make: "Tata", Model: "Nexon", Color: "White", Status: contracts.StatusManufactured})
+	require.NoError(t, err)
+	worldState["car1"] = bytes
+	chaincodeStub.GetQueryResultCalls(func(query string) (shim.StateQueryIteratorInterface, error) {
+		return newStateIterator(worldState, "", ""), nil
+	})
+
+	result, err := carAsset.RunMatching(transactionContext, 10)
+	require.NoError(t, err)
+	require.Equal(t, int32(0), result.Matched)
+
+	// Assert only the open order is indexed
+	migration, err := orderAsset.RebuildOrderBook(transactionContext, "", "", 10)
+	require.NoError(t, err)
+	require.Equal(t, &contracts.MigrationResult{Scanned: 2, Migrated: 1}, migration)
+
+	result, err = carAsset.RunMatching(transactionContext, 10)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), result.Matched)
+	require.Equal(t, "order1", result.Matches[0].OrderID)
+}
@@ -0,0 +1,66 @@
+package chaincodetest
+
+import (
+	"testing"
+
+	"kbaauto/contracts"
+	"kbaauto/query"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryBuilder(t *testing.T) {
+	// Assert conditions on the same field are combined and values are marshalled
+	queryString, err := query.New().
+		Eq("assetType", "car").
+		Gte("dateOfManufacture", "2023-01-01").
+		Lt("dateOfManufacture", "2024-01-01").
+		In("color", "Blue", "White").
+		Exists("archive", false).
+		Sort("color", query.Descending).
+		Fields("carId", "color").
+		String()
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"selector": {
+			"archive": {"$exists": false},
+			"assetType": {"$eq": "car"},
+			"color": {"$in": ["Blue", "White"]},
+			"dateOfManufacture": {"$gte": "2023-01-01", "$lt": "2024-01-01"}
+		},
+		"sort": [{"color": "desc"}],
+		"fields": ["carId", "color"]
+	}`, queryString)
+
+	// Assert a quoted value cannot rewrite the selector
+	queryString, err = query.New().Eq("make", `Honda", "assetType": {"$gt": null}, "x": "`).String()
+	require.NoError(t, err)
+	require.JSONEq(t, `{"selector": {"make": {"$eq": "Honda\", \"assetType\": {\"$gt\": null}, \"x\": \""}}}`, queryString)
+
+	// Assert operators cannot be passed as field names
+	_, err = query.New().Eq("$or", "x").String()
+	require.EqualError(t, err, `invalid field name "$or"`)
+
+	_, err = query.New().Sort("color", "up").String()
+	require.EqualError(t, err, `invalid sort direction "up" for color`)
+}
+
+func TestQueryCars(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("DealerMSP")
+	prepWorldState(chaincodeStub)
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	// Assert only indexed fields can be sorted on
+	_, err := carAsset.QueryCars(transactionContext, `{}`, "carId", "asc", 10, "")
+	requireError(t, err, contracts.CodeInvalidArgument, "cannot sort by carId, it is not an indexed field")
+
+	// Assert unknown filter fields are rejected
+	_, err = carAsset.QueryCars(transactionContext, `{"engine":"V8"}`, "", "", 10, "")
+	requireError(t, err, contracts.CodeInvalidArgument, `could not parse the car filter. json: unknown field "engine"`)
+
+	_, err = carAsset.QueryCars(transactionContext, `{}`, "", "", 1000, "")
+	requireError(t, err, contracts.CodeInvalidArgument, "the page size must be between 1 and 200")
+}
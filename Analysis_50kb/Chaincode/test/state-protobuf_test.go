@@ -0,0 +1,210 @@
+package chaincodetest
+
+import (
+	"bufio"
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"kbaauto/contracts"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+var (
+	protoMessageLine = regexp.MustCompile(`^message (\w+) \{$`)
+	protoFieldLine   = regexp.MustCompile(`^(repeated )?(\w+) (\w+) = (\d+);$`)
+	protoScalarTypes = map[string]descriptorpb.FieldDescriptorProto_Type{
+		"string": descriptorpb.FieldDescriptorProto_TYPE_STRING,
+		"int64":  descriptorpb.FieldDescriptorProto_TYPE_INT64,
+	}
+)
+
+// loadStateProto builds the descriptor of state.proto. It reads only the subset of the language the
+// file uses and fails on anything else, so that the schema cannot grow past what is checked.
+func loadStateProto(t *testing.T) protoreflect.FileDescriptor {
+	file, err := os.Open("../contracts/state.proto")
+	require.NoError(t, err)
+	defer file.Close()
+
+	descriptor := &descriptorpb.FileDescriptorProto{
+		Name:   proto.String("state.proto"),
+		Syntax: proto.String("proto3"),
+	}
+	var message *descriptorpb.DescriptorProto
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if i := strings.Index(line, "//"); i >= 0 {
+			line = strings.TrimSpace(line[:i])
+		}
+
+		switch {
+		case line == "" || line == `syntax = "proto3";` || strings.HasPrefix(line, "reserved "):
+		case strings.HasPrefix(line, "package "):
+			descriptor.Package = proto.String(strings.TrimSuffix(strings.TrimPrefix(line, "package "), ";"))
+		case protoMessageLine.MatchString(line):
+			message = &descriptorpb.DescriptorProto{Name: proto.String(protoMessageLine.FindStringSubmatch(line)[1])}
+			descriptor.MessageType = append(descriptor.MessageType, message)
+		case line == "}":
+			message = nil
+		case message != nil && protoFieldLine.MatchString(line):
+			match := protoFieldLine.FindStringSubmatch(line)
+			number, _ := strconv.Atoi(match[4])
+			field := &descriptorpb.FieldDescriptorProto{
+				Name:     proto.String(match[3]),
+				Number:   proto.Int32(int32(number)),
+				JsonName: proto.String(match[3]),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			}
+			if match[1] != "" {
+				field.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+			}
+			if scalar, ok := protoScalarTypes[match[2]]; ok {
+				field.Type = scalar.Enum()
+			} else {
+				field.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+				field.TypeName = proto.String("." + descriptor.GetPackage() + "." + match[2])
+			}
+			message.Field = append(message.Field, field)
+		default:
+			t.Fatalf("state.proto: cannot read %q", line)
+		}
+	}
+	require.NoError(t, scanner.Err())
+
+	fileDescriptor, err := protodesc.NewFile(descriptor, nil)
+	require.NoError(t, err)
+	return fileDescriptor
+}
+
+// requireNoZeroFields fails when a field of value, or of the structs it points to, is left empty
+func requireNoZeroFields(t *testing.T, value reflect.Value, path string) {
+	switch value.Kind() {
+	case reflect.Ptr:
+		require.False(t, value.IsNil(), path)
+		requireNoZeroFields(t, value.Elem(), path)
+	case reflect.Slice:
+		require.NotZero(t, value.Len(), path)
+		for i := 0; i < value.Len(); i++ {
+			requireNoZeroFields(t, value.Index(i), path)
+		}
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			requireNoZeroFields(t, value.Field(i), path+"."+value.Type().Field(i).Name)
+		}
+	default:
+		require.False(t, value.IsZero(), path)
+	}
+}
+
+// requireSchemaFields fails unless message holds every field of its schema and nothing outside it
+func requireSchemaFields(t *testing.T, message protoreflect.Message) {
+	name := message.Descriptor().FullName()
+	require.Empty(t, message.GetUnknown(), "%s has fields missing from state.proto", name)
+
+	fields := message.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		require.True(t, message.Has(field), "%s is not written", field.FullName())
+		if field.Kind() != protoreflect.MessageKind {
+			continue
+		}
+		if field.IsList() {
+			list := message.Get(field).List()
+			for j := 0; j < list.Len(); j++ {
+				requireSchemaFields(t, list.Get(j).Message())
+			}
+		} else {
+			requireSchemaFields(t, message.Get(field).Message())
+		}
+	}
+}
+
+// decodeWithSchema parses data as the state.proto message and encodes it again from the descriptor
+func decodeWithSchema(t *testing.T, file protoreflect.FileDescriptor, name protoreflect.Name, data []byte) []byte {
+	message := dynamicpb.NewMessage(file.Messages().ByName(name))
+	require.NoError(t, proto.Unmarshal(data, message))
+	requireSchemaFields(t, message)
+
+	encoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	require.NoError(t, err)
+	return encoded
+}
+
+func TestStateProtoSchema(t *testing.T) {
+	file := loadStateProto(t)
+	serializer, err := contracts.SerializerFor(contracts.StateEncodingProtobuf)
+	require.NoError(t, err)
+
+	dealer := &contracts.Identity{MSPID: "DealerMSP", EnrollmentID: "dealer1"}
+	car := &contracts.Car{
+		AssetType:          "car",
+		CarId:              "car1",
+		Color:              "White",
+		DateOfManufacture:  "2023-07-22",
+		Make:               "Tata",
+		Model:              "Nexon",
+		OwnedBy:            "KBA3",
+		Status:             contracts.StatusRegistered,
+		RegisteredOwner:    "Jane Doe",
+		OwnerCommitment:    strings.Repeat("ab", 32),
+		RegistrationNumber: "KL-01",
+		Owner:              dealer,
+		Version:            7,
+		Archive:            &contracts.ArchiveInfo{Reason: "sold abroad", ArchivedBy: dealer, ArchivedAt: "2024-01-02T03:04:05Z"},
+		WMI:                "1HG",
+		ModelYear:          2023,
+		Documents: []*contracts.Document{{
+			Name:       "invoice.pdf",
+			SHA256:     strings.Repeat("cd", 32),
+			MediaType:  "application/pdf",
+			Size:       1000,
+			UploadedBy: dealer,
+			UploadedAt: "2024-01-02T03:04:05Z",
+		}},
+	}
+	order := &contracts.Order{
+		AssetType:    "Order",
+		Color:        "White",
+		DealerName:   "KBA3",
+		Make:         "Tata",
+		Model:        "Nexon",
+		OrderID:      "order1",
+		Quantity:     2,
+		Options:      []string{"Sunroof", "Tow bar"},
+		DeliveryDate: "2024-03-01",
+		Notes:        "Deliver to the Kochi branch",
+		Status:       contracts.OrderMatched,
+		CarId:        "car1",
+		CarIds:       []string{"car2", "car1"},
+		CreatedBy:    dealer,
+		CreatedAt:    "2024-01-02T03:04:05Z",
+		UpdatedBy:    dealer,
+		UpdatedAt:    "2024-01-03T03:04:05Z",
+		ExpiresAt:    "2024-02-01T03:04:05Z",
+	}
+	requireNoZeroFields(t, reflect.ValueOf(car), "Car")
+	requireNoZeroFields(t, reflect.ValueOf(order), "Order")
+
+	// Assert every field written by the serializer is in state.proto and survives a round trip through it
+	data, err := serializer.MarshalCar(car)
+	require.NoError(t, err)
+	var decodedCar contracts.Car
+	require.NoError(t, serializer.UnmarshalCar(decodeWithSchema(t, file, "Car", data), &decodedCar))
+	require.Equal(t, car, &decodedCar)
+
+	data, err = serializer.MarshalOrder(order)
+	require.NoError(t, err)
+	var decodedOrder contracts.Order
+	require.NoError(t, serializer.UnmarshalOrder(decodeWithSchema(t, file, "Order", data), &decodedOrder))
+	require.Equal(t, order, &decodedOrder)
+}
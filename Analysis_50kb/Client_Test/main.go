@@ -1,8 +1,10 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"sync"
 	"time"
 
@@ -15,6 +17,9 @@ type TxnCounters struct {
 	mu      sync.Mutex
 }
 
+// payload is stored by every transaction of the single and chunked load tests
+const payload = "HKcYnb5oRnOJoX58zv8ZPER1PHLi6OlEbWxwyxGElCCpkU0ReJXhd9UhB11ByluVIwQawB5yqlLDuI9qvCB98arAcHVip689vfDJcu6uRbtNIVhY7E8xmlCH5gSEvFBfkLLCpDTQafbJba4Z2K55uAWUUaFW67Q9HsrQxYPRogLA9ghW7PCtqiWBIECTRFiVaXYmdG04T95KgpR50kyJOSGU37qbBpdmYdQDdKZnpjpfmyssfqqpJmHT90S9qOEJlgqaZRAHwgNwBzjjWj3Vyu04d39ROPbdiNSKYwcDIlmzUxpkFslP6ruwX055xudATbtvPpyVgLdIlZL6h0h2nyAz7kMspCQc1q6ICWekAausYGYzYhQE9WHK1sJbAr1efIXA7WUX5ykjmWLhpmZ4apHoMyfzc2vRUQweaXX3l0PTaObMFGNSUkhRpQA4UJ0U12GHQPpC2580Wu7HEAUUeTkb8m4SJVEZ5JwianUEzSnTZcvC5kYLhyabPXih13G12sfpeZhq0Pjlki6aPP8oqQdo2eFsVBt6Pfyp28fBA0U0LcjL9hQFUNjKKCTU5oTYjHIJiByY580LqLtCFfO9HJVaLkAm1MJuP9LHr7xn9ZpxZRqR7sz0iluTgSm0pnK0g6dSTOJqporyNmYq0FErFpG1Y0MqElTlMZb0DJ04x4j86A0qqLIJ1dCuuhWAsATm1qaAMA1bODmKWAU1AKL2jzgtVlYrHS8Nrb9guS69acFbH6Po3ixR7EdkfCGOHC3H8xUX1iHizZjouk4aPN8oRSlHXOatNSsiwZUFfeMgx4wrAdI5vpdLzGuj4fDeng9isEKQDCAvY14sLwjNzZ7pJ1avnmlbz3zvjUS3ub3zqE6SqVGMM4uAKDJ6L8g4iCsfTxJdspSOWquTbnNvSqKTfsnqOnl8oClZWRzk4ASSVNRl1NKpFRrKUCVizxg1KCzf5ODdtVmKt8g9fl5KatlaocoH2F2bf4kHGWYQsoTaLdxzGSJNUY5vJ3rZdjMFCbl8VHvlNiRy7ycPscdHQRoTs6j5ERXlUMWOMecVnZtvz3JwvRTZ0SthTw6bfFIsf5GV19wN6sLbfo9GuKY4AdTRRCWzQxFTZkv764ISFAbn2vW9Fs0KhDUCShImK4TnUCFs68RAaCILfPK6DTZPD7SqJ95EHotOHnbCc6u1aeFjN9kVte3gclptuY36YxRXLEp8rzhYeDErPPfsmaDUu2Sd84FvJbIvSAv23ZgZZTvZqqATHFcNlEUdUoklndVTsBqdBzGmAaGUTqnPBiflgNjnJM1oL1p2jkNp0m5gY3zLjqtdCxkj9zUwKnVQ5V76LMFpsQR1ZOVoVzAPCtKFQouTVgRGuZffuIdGkJlHT0pSkCponAulWsLLJdjA912dSLOhYKiZLElBfeZhEqzgQcVA5pn75GeeH2vrCo2giEZyEXuIaVxGswTxlRGqPOAiq0F5b013LD2VKYIidBuOlzlK8PBV0RDjlY6pfewRpv1bKKLhxjowdVNdkYhZOaQYPCBrg1Pb4QTd5y1owp2CTiR4Of3yTzFTKgHWBSJaOpbHxsnthzUzDZyk4bysNS4XVH7JJMRVaWzO43CGk9klnEt7GV25IycwYNKO5ZB570sJfIsRz8Gkr2LLQjigVAnni9HPEmfOcqgUadGd0sE7MYwAbFBu7jGfJqRB2TYfAbYaPtwkKuOe4rySmjCDK3vH6Tizv5Y2NGFFbA683g51IrogP9zCyugJs4pvgVZnXAB4G4xVGSzYehjRozY0hzErCUmUQ3ecwgyMdpCOOaIvWksJ9NnRFAOpDcsxEQAna8XthhoUC1HKSjOfPAwnaAX5iMXMHyexvnps5EayzWk7DXh3Os60puqsZU4ILhrbYhw8EFBQaAcBLnmpFN53CpmR920cUSkrbVtZtrifa37A8bzIUnkxazCsdtvkbSFCunEpoT5bWB31r65FPgQZ3ASdAiEzhH3Vh7vFzS3T0WAJAuH3TFYw9K7xbKkiGqsjv2sB1lJ4u37zW9KtpepyOaMfEKUVntUhCc8oFgyAIriF42sK4u9ktPc36IhggvOTNqASEY2ZYwYCronSSV3SsfNYaUZZg75JG5CROcfDRRQrTyKotYqZ1KNA0mZWsDyobpJXnzfR8lwpBJC7PUJkaCcUZVKBugdZgXNjdRXeALX2jUGueuPztzXWanz4B5mKfW6JYFKLyJXh8ynv9rJni6g34wb6S9JwULiOB2cDgkX6hl8vkRlEXzVTgA2H25qftRtlnMODGllkdisUIxHf0hF1oVnElpE1Blxz8e66nM99jWcUxJYod1CbagxvKcZZwxy4iphctyQ84hHBlPxMZ9Hk2EtB3P12RD5jn0hedoBNM7o6DCnLuT2TnDiig0JYre8Qqrr18AeyXY3ywrNy3cnaUHWNWX4KiSI9nZWvV9sfzxfElgcCd8CjsKD0xgtoYXyJzk25hr1yfuz6dyghvmXuaR2RzpEnpH8imG6uxX5PIVpXzonHt2CyAVZP0cWxBK97Thj1jrepYJbwzf1x6JWfATUwnlBxuAesnpJxgb3aJqNA9JpHBBgJHOdu3j0fnq76uAFMn2iyJ0B7T9UBmwJIG10CYHSLOUcI7p3AbhMha3GSUUL1uVA7KqjSFsvOKb8vHtK6scefzIcFhCFAJdxWptZzXs3o2u6OKagKDpQttMWMuD7qCoA7vWBDuCloAhJAJNhv1AHwpwnLDtVmvQB64WIUkSDLcHXpX0Qj7ag14b3y3lDJW9m3LXqExi6Z96tC7h9RPNKfxmJoNN9rTvp7x6jDdAnsJxL7cJigWlw5NievrbfP3PXsVsFjF5sd1sf13WL8dib7NBCVKtl06bAwNJVQofQaMG6iSlBj6jLZ7k99VzD7YJHCj0W6Tbz3CP3zc8QUPhA0VUpUi7diVdPMu9z9SVGI6HDm4a2Ua9W9Zxcqy6TL7E6GqscZIT41FZYwUj8bNdsYLpSW53U9eHXyrCv4cSciDnFKIPwuhF4bGN4kyIcAzwwrreoMS2QBJxewW9FuocqOo9RhDyo0jHZDS0jUVFwGhRa3vO0Wwk1zWq5BqtMeRBIsByFzNqUq0FdUov9fmT5gSy0eFvJ3vbUCSidWAP8ujaaN002j4KI9LF1Ik3R6apSEQNk4PrJEHnGllK4NH6z1RMfuhFj00MPWbGALFQXQbRb35BEycwgg6fU2zka8Bg3QYGqedb2eu7IuxyyxFILEFe19gkixgOGbyuafDz2zHLdF9b31VYtDOmPOHP3AvpKyHhsb80vfYeYpQBypfzOA5gyEEzx8aAMsGHkoKy9c9mW1sVHt5cVRnnfNvKtTa1Iugy508XvpVLwenbdJ7B0fd8m1phaEy6UdAA4xb9jsvBtqDHnav8p71WNIBsNpxoJriA6JzN7xT8MTeK3wwOsrTVwDHEA2G4XllmzjKTOqIzlgKLGfUQxQftAMLMEpZ79Jp3IuzjRYq5Xb49gKasciyumiH7Vr1dSG2BFvGshT0qPWiJg2Cl2zLirHg0de0gsJJ8Y3hJ0jNeMpa0RnfIwPe2sdPR89cTCiDabLEG0Shl5s8epvlnEfJakqlYJmCTKPqffMT3OhGaHMWyDlt9qczKmeOspOA3Jm0JXKDOWwuqMh5OqFEmr9zkFsACW2xLPWJ14bsHRF5JpVigwHu69m1OiXJlMaNPRp3UTygPIyLt7Hd2sDWSfd15jKMBoBw0HKhkLeU1aAIGsWPrZI6fdp08Bwkrx0rYO9RBWekBHLBjLjPNs3LR4SriuZW5FSD4WQ2oCukPjAE887nXX0dVaqDmzE6t3zlA977Vm54vdtoZDQF6Af7T2U1tJHWquvf5rhsRIZ9MItme04ZFVdBzeCpQVIYliRNKhB6rkACR6YqIs8wWxlAeAdgSrSwu93dEfYn54yNRzcGW2LkDswbVnF3wyXizUvByUQWDUEIV49LHxGnzfx5s9v6PwvP2Onovz9de9YIntXWkEoJKa4Snp5LagzPqWPowMp4xe2GMUtsaglWbxGMAzyNpM0vainxmdzNm3hLuuop8xDyPifVUe9QD4BpxGGY2zeirE21JFEpCald3ZobcR0wbM5ODBtZ3ZeITIFZRPQRgl9Jd1MzHaZaASgUUvamoQe8Lgf0bZITXW8LE0bi1ZAeCDxgKu8YakMlEsaI3MjG614FVe5Om11dx9M9iPBjVoDxuRsaK39SqBgKzGvIVK1NhgEXb8rx6XpQxPXQOgKfht09Bz6fhKYMXEcQLZoWGPaimVXhbqBpwCYqgeSPIi0Wi4Hdn7Mxa97XiRr7AmO9G3uaxQfNbszc8n3lRmh41xLVTLG2I2xwhyOVnk3Dwey2meVhHUoan89nBKlYXKiGpu51sBejIfesT2BR2s9vnkRLum1kkW3DdqnMlPWTCWlsAKRWgKt1jUzuoxlzn31nJB124wTSswKJJvmF5SFQLrptaJQ2mvGvdLC6Ka2LOuLzmRz2Xj6eBOy7XWtwJObR7FdOQGRK3XZoCeykrjrzlUKdB3anGzieA8cfVwGTB9cRJeDj9KvCFdSUyugmYVXfKQ3AhXqabxc6EtSAjoMRRxKvniVXs6fPueSl58WYBtxnDoXOR8cZvpYfPn4nbJutYLSwY2Ho5eTlZ5c2Lv5AFsSecN1pR7yeyb1Ah4bNP0TY7aehQYk9sAsI7G5uCm8DwlG2WDCd5E5AaoApoI79ERVbJxuW7CXcwjJH12YOTLXRnw3VBrKnUubQTfssGNo0G3co2ELVncsZXtJgCATIEdF56p4yOlt1dcgccQXuwlujmfYMEBnO4WRxJFzv1AhUiY1FK3e4cQbNOeZg4glKtMR8KhpFAGaDo5f3bYXsITu30xwInyWXS8w5RLCMj9xeKzD6SR3thQqNosD63RTLFmEShygOWZbiEzX8xiX68fMYv2oV3JXFkfotxSnkZQVkTatzoGMTUznhTOYgc6D4UvEvxI6b3OfVJoBMuoo61pcgjBmC3Hxlu8TtomAahluALjDDsppV96DnIeFVTOSBbdwyfnsJMnGaZQTboFdx1zhBwlkvmWewq2QJxbE8LGyRDFO1e1S4L47EqqEewbKcFGlREpeWJGxPj53c11sq85jWllcS76BNwvBAwD8fj0oabfamNmDIOZl3WPgFtkvgVVUHXQmZCbA2hMSHSg25sojoH3V18vqnxValmduoAdb41TAfzU8BP11vARZirDqEEJrjdH8mxl9NLt3Zlv1gPMU8YyhvwZekGEcJqfwzqpNHcfQyybMl0yHOdLdJchs8NqAgOey3skId2OfLBYcPGEKiRGNkhA2joRfVohtdDurtYDXaaZiYlNziN529m6VbOT7hzfbBVFtXNZxrDDB5AEdRtVWXngu87F2ZdLfD3t5ibGLnRWjvqFQFdMgYmhMl54zpXzq6HwTTGqVoM3JbF5BAfwQA6w0LBjYh5cWUsy5FyBo7fvZiTSQGDNi8Y8aP4xkTlaoIxJbe5oiv1mXeCn5QtiUHpHETLyz7A7GNoqy6eiJpSSW3nf9EL3ArgxdnIChkXwkCpimOkxBAJARiXBXi8LpqWbGyczuhqSzQHhmjdStFtSdHr76CVaR5ea7vvjl3l019C4ZthJAPHr7MrIok5YexLjYjIaOfNAdcIfZkGBFJvDWS3ey6TaNSSLdeRqTSlLI2QH6tv6oOFGPkJgaKxXc4dqQYPS8nX1ioWKEtcCSh3o8uA4by8Qn6RGypC0qWWp8QgpaaVr58Jh8CdOCB3Hfykjem29RIbGckaL2P4P3Katu14rQsIEWc971s3fO4SlYRKgGRCqRXo9IIS2n5BGaSGNZit5IE8b7ZI8J2Q1BmwuYumZ0JyFtQw4ZjyJmeCM37bWSBIB0ZM3ygE7yPqV2Gay2QHzIWNRY87hzAUwrbZzg3Elv8IIrQ7uo9gjmWe5ClyY4LMc5tlMJWtaP1Ngv8cT1mi0lfhNSB9s4qfJH8SP1dB6aP3S02k2FYHYXLLioyBJ1HnTmbFs672V3XWUD4opbZMdDF5Ri9XzOvJWjm2KmNUPcbnBnQoH4s6YgRgAZbY5qsbnfaHFQvhUS7i8fvrsb3uTOyLNvq1VzlzucVAbKnJFB7g5MBrKCpLenm6TCZDfRha4h6Nks4R4wC3Xvg7xggGKXYYxHmXcVIsqKnRtbcSh0r03f9n50Rgc25LSuplikJsfvEe8WfVdPvkW9F4b2wFXfZje7SKMKl4YFcM5wncNaWPVV0ulPgVun2BGCvgaoYhlleays02G7POvEGDHeo1wadDn9JLSZ6zwUtFcRSugc75siNzMQJyOYDRobxzauXApSy5hmpkbL37OXT98xbacMKCbyqIGl8ShuDxqaLpSmXG2UWx7sks0coLOqHZ1FAILw2ZlA9J5IOrqlFcDo3QXuYe0C5jN8JVyv7U13pfUTzcUeUZBnbDIJ9LFnA73YldhZy9d4796s7mgcuPCBjM0a6wlLArWbjFHhhlk3B6BxQE4Nwz8JQjpKmXvMHMTvJooLfcBeEY9uN0lvOxCmUGbBXIZLaR1HtVwSmJRX5oooYq8wAAQydnsULl588L34lMDvyT9rvsX9a8AiCRQkElGqP8ZsrmIOehkGPIf6tq3uT5S8oU9F4fqQxB8tA5BCzADRWlKcJYW8LJENZNN4273AduNQbz0SCFaqdfvjw8c8I6EPv5djk2z6PnNbFzhQ404PaoV9kt28LeyWnVyXVzhofBnvGk1Lnk4DsXbRUj5aD6ma5TB1vrnM3JiE8gpjf5U0aWzmfYbcr6mYDPFRKeVMeHtmXsqXwEhiVEGkNVdRU3QhJZR7A3WZnvedj9KAk0WN3Wn4Ulmgv3vKlTb8CAm7vA49z3UfXbiZ7UYaV9aFjPJpkJQfTYJZS00NhCzxB7uFD3wZVwHwU3m5MOT2kQXYKMsgAqXp9QGeUEYGaF7hxjkL4FyOwpIvjr7QoglQcKvw1Mag08aicFI5U8JMvkE8au0g96gTQJqCemR0Wzq35A2A6lGQCPVwECNc4bRCNJAOdjXCIDD5OduVm0MhEh4JJMuadwDICPWRmN2bNGht5a1Y8xTRFTgpMAhK0q7Rx6MzT7Dks7EVw3MhxcHa8neUUclLmE5s9P5dXlyVC2Ikhh4P59VLEue0vJJBtq4MGdhQwmwduxaQO3sDvKR4Wf9uagXKgO6skSdTnydyUqYaxYvWzKtq8sSFVP3ycUWnusBdCFt7q7QruSrlcHy42RTvqXAI0lclTPHnLIyNs7lboxIhy6SY2hfbj39Zlzul3zfHNEa4iNw2RWneqIvngNh0c6RFaHxPB6KjqksALLkjOcmMpjKBVgGkisQSDiP5NL86auzx1GweGRUTwqPgdL2H2EnpXTl0nUgPfAU9ciLLxDFcUd7YaJLcw5k5zvCIKNTGaFs9o5klvdg3ZJLHkaNrajJy6lELGtM8lnCq2b6PErQ0L3s5WRuSat53p31ZbzA9o9e2THkTV9G259uOYsJno4oqAkp9ladsKPlO3O5uv3a5i7vJfOKW5jQYMXp7ckkY4nWLIw23Su2MA7PW5z9zOqZJHjgOav1sDXcUq60hTvKHhiipBpItQHDbghWy3jWvJCPehKUL3LDZGGO9AxDlH03pibSsVyfQK0HruIaYTvFY86HAPN1LhSCzjFQ0sSpgNY1PlAW2jCbSw7SAsaB2A9CrTVimHPLVDy94brq2QD89kbZb3MvLNlZ00NYH8iA4jPKJ1jwOWbEuHTK81SyoJZMN2pBwnu1clyGSyiPHLbkODMZo68jHRc1Oh8sAMotjnK1paoJMP1rZ0n2wcqG8C2vFy7TCoDTcgePiPsWPs3E35V3SRFydTWh3BTm93FYrKmPUOKTxntLZgb0JwAdlt4UigrUqx07w5mIyXDkdhWMgHFfkR3ukNKbXQMcFOlGWBAct72aoNvRgxB7cTf32ZzncoTwFkCet6tPzesJ3d5T0EdvW80fC4zIVAjjK4m5cMghvaG4JsZBwfXISsJasj4kndPZvp3DqqJbPrOQSPzDlNRQiPSCUi8KnBN85Rmxf1UpjhxbxZ3Ot7nYcmD0I2lyIAVytSGq9EWBOfFz89MPG4ae3VDLEnjVvYd34amKWi4jbP9tEY4x5Jhx7lxfCV2YuPo37DLqfXrznyOSy0iyYOjgTXjyAt1H4Iv48ElV4Bhxxn9tDMq7yDaNYo1CGeHoSkNpfiDeELFSGYFfzoLERx4cxtKPvCrH47yQG4lvdxA267vJemK2nFgRstQMgWpUxUM4HApXUj643aPEOpGuZxNjIIUKDjnpLZNIwzk4TgJit2ogXHmxMf4Zqae7U3UHW8hKbpnOhg7UG2gs6cQviKzC36SQkR0xRSp3yyeuM99naMt52RSwZl74PAtNfBmYHJFAC7ZTJqYGxzjWZ2z3PHINADYxgMUQxMtoFiTGu3S6ZgF31gvwZB6QWpWiZ8HkS52dArZWJmA8kWkWEuhyyULLLHa0HNkqpXRMfdi5FLrVTHY34kba8IWgt2VxRQNWtyr2AGo08czNlsplBazg7yptchBS9HV6lqXYXYlPAdqbqWTcFr6ViKyI1zNzgGCgqnSi3tazO8HwJlWAanCssWWGWcAteWGMnemZymF4Ipj5Oj70la4Q46Z2uUYQ03zPOTlpJeR7Smt28miZlZgUGDXRP7tCQi6fjyLV6klYg6csq8rSl9X9XrXB442dU17rhZ4hbwUWm08wD2CuLyqsZ1dHmyxk49xBu8XA3u23bT8lBPIrB35n5qvIAwiVHXqlIhifxZyvDsJHCir2unYYpUjgpb8V7sU93b5CEeAo6M7NBMLt4IRoVx1NQTx3DwlgtaAK0LIY0Ysd6PRgmd5nBNygEv7vTtOiXyzmATCHlrfe2llzGoQQVN7zxHI9VIQ5BC5jjHokoje0sR4XSSEBWVxg49Hy0GZzR6FdZsQu2dXkmiSRrZt7ePPXdKfDjEOXy8gl8uPOJESTHSsFWT39JQREhGyXzfGJsDTXvQt9oQjUpq3FDqlkwmRUjtNUxDSllHopaUc5TWaypigSL7cTA9pkDoegooAgR1lsud2SijHoXl0jTjjfPnlWtmBiB9pe55htEAVmnSGSvn51naApYyeGSEl2ZXkJFSSTnL3IoW5oWg9GrtECeCSJEJBvuwAHgoiXkErLnzkvcpkVuXyOdhuJLYR4Ax65STZw2C3hYNRSSpKq5sBtCwk9MDbqQFkYt6TtgfIbOBFSUAAKsvxx91r407AmURVGT3omYrdqtPuyUndlKlOE82R1oYngzXdllkfBG8rvSZi2hhBdYecH8rrd3MislVEW8TxFQeqwqye3Sycl94uziDtuIRo4e7spRylvEOMxrUaU5Pje6NExXDMNlS9ebZTFLAAaGT05Bk7CoaF0MI7AISwQqbbnJIcnafJW9o6LnE2pSFU67UrU4IbEm8y6AhrJYpR7vWdI78JDEGtVbh86Fer7LfctDjxgG8joP7jvf8KR4261zbULB5xHvjeBqP1l89gigrAmKNSHaxDaF2BRVMEdk9TDc5nesdLJrjBZukiGZzchfIy4PrJAejhutXCwf3FEqB2iYTn5LlrMNaSec2ZTPIE5xiv58x8ImBCcYjIaFKFfcruzYpz1ls74iXHLJKhRysRBr1maIikDRntpWXqpoPdnX5fUkIcFKKwnWOMA4UIfYrWdyJ60tBG1irI8Kq0yzuVn0rsKXxXlSNnmuXfybP1yf9SxcF5w54DEJLdecp040PBnJeSqcVFRnLdwXbGp0bGc6gquomvXVpeectV7dS1LBwyK7FIQD1VFppvAoC1FCuzCEOZe9cUsUGJlo2bE0cw5qJ4eS8EiJxt8T2KIhJzW7jig9tW5HSgzl3KVCmjK4AqTTCGERYLCFQYliyYvlQDnmKcwIweYP70fyS644HvgSn0Lmvy6kwhHV9fvd63jTNLz7dYIp3VQ3NaS0B0jSgYyy63F1812sYKljLzQpA01rAapgqPoA5PkPmSXzmmGkr7s9F8cw3kxcLPz3SnUMR9X1UVM9q5jOABA6DA02ZLZ1kV52h3Ki0hoPoDsoBEZifWt0CwnjPXMuSXAN4cHNqkqDfiba1SCJL6iHBw0SmW5Uay7BQVN9fTCesiFhJOWSEuewtr2UtMMRXxutBWliAhDeQtjhr2EIFJUdpv1dGYcIHJoiKcFEX4Qt9yV03rhYD1ljUxxF3UjkP9JpLa1pdpZ2Y1AFsY3P1peiXVRXseKvQviQxmBJvnnnWGWnyyaeeVxWJTCFY89Dnhw1YCb9aGxSfra8ZA7cYE39IDR0Ndoqi4pmG9Bp0vk1bkCPfi2Ix6oByMc8ZSQXuZFtfdGFwnkO1aFT06q7LZlB1qsZCBLDogD27jwdYHanB7eHRUuwz8n15LfLiDTcGsIS54bcmsuj0PEYTL8M47U12do2y8DHbX8AzHYB3Rv4rjjIc3tE7Mmipl2fs3ZLIwibm2qt06t3vcmBu6hYwcMTRAZvaoJKMmDb2Lof6gXFLh6cD0AGCwVDCzWInjzBktQExeSffctlVhY5bw4rIEyURCDo02RUax0inqjLtPVJ6iZ26cEpdSr4prnLghTyAzKxKqj5tIqfXrJCEwRgF9njcDS5locRJXntlbtjjI1cxeTWzWTp3BUxfxiCeWx7xObtPTJZhSngbOfrx979J2ihd9hwcj3Sdi7odHViV9WYS2Uzw4wI7XLa1RrZZjf1s79UN8MhlYMQFH7WOOZACXaSgNQbtQH6cT6mz29prhBHm3G6XSyeNIDHlVFom7bRjZT5zg5a7BqRQNh73Tf6N8xoNDQQfgZSnlYmvyCvMwbxhSyyiPOTsjHkZRL1Uw10M3QpuXAHzoijgXEAW869gaku6COTCoBiJF9ABOkqO2mxb6x8tEPeM4agIZvdN95nTc0FqRlG0g8bGlDxVRd6cbrBWTGaveOl78zGqsG60GZLhyUkahIAgD1yvZuWHHAAmnT8g1RGMaeKK7Uty1207LEmvQgRpylvRHx1sbqPM3vOiHsix0nQoyaXtlhQFRI0tVuPpeGqOk2mdpDb2AUG3b49uESGKjiUfoQ5FzEXvyb3peTAlTpiVjfmxDbGrI2KHRNFHxdvX3OPUIgRpjlvPUv5WobmsUGMxrcjrAdCCuJB7qeDMFKRKu9BTkNlHTIY0FPcr3VUbLBPEg2eLiz2NQIME6fBQ7RWtO998h1s1r3tT1ca0eHmynID8lht3D82ajDCYx9ASiDhbwf5dddGKjX3loyU9un35B29pGX5CaSRlX89lW5zkRk5T3TPgprOCupe6v1ic4W2xbT5ZD4n9eGIPWEMKd9kLaWLn08LjwnG4WSABee66HrVNu5UxXxPDBLdmAovMPv9nhV45MlOQaDrUnFXOHMq9pwHlOAhNLzGkxNcRPDWP4gychPqh4lTTRxAbFADYrPT7p6ociaIZv0ZbhnOtylguiDZABotAnyj82YASRyoOpCkYxKVp5OWQqncerwjHOHX8rsTmnshkrHafkVhipHuRaXMQarvI8rX9vb7FYU0q1HCu2InP8639lneoS1TvxNWChSVNfjmKBXkv2SE0vuZ8gYLCOHlTaGsJkhpdyOxVnoftJUeEBr1VOTnRBFC6k6B0jEWHvzjvrpGSbyLq0ClIE35CQWeh22EbehZEFsn2XEgwE6zO9RdDjmjJXAwHCQYcoitbUBe3XpS5xjX6jWCMqRbMpCflbdPYEWFTl7mZwZslfMrDZE3I08t3TbHaL9C6nUqWohyGyZ1s2kqenUWTTA1GfgJB4jWYkI70Llg1rJeKR8L4OuTz9K7qaidE7m2UYjp6lCAzspZE71VxaGzqtVawDKd26hZ1gU92QUoGMBsJEmCIJNk2b7Zz1fAsZ9mJmBtMSCvmkrL7r5yvf7suguhY2qm0oLQ1cWmomv60HsFaXXUUEiCwwON6j0sfZ5XLgKOvZV28SZv8X62JYDnJumTJBRmGyPuaSVqnPWHaxc5pPt7KzP4XQWoWSkt2oxID5HQBOzcneC5W2PVAc0afCiQxeBay7cFV4LmaRuFbiYY2mQmVZFISKrkx7dIkwQCDbaBGF60MIS8Vxntad1AtL4C0NpfetRqK9QkdHI5RvilYnMZPUEwMGv2P4PEUvXutvDwy3xb8Zm4c2lpDCAGHE6WXm0dvQFWJMuvx10SgDhq6VHsDTqLl71m1yNUI7Rb7tUgfzI6vc8FP2Ob5ChCyFgjOZGXPqil3BP836E58pyBuuRGsixj2s1ksn4U0fA5FxDG9iPV9JpzsMshIXlT7jCblCZYxiwO53kZU88V9544Lup2zjk7yW4UuORT2XevPBm7fE2mYyRrwcLXlwRnKcsuSd8Brtbw7aAcwImbGsfjQfeMSxaQ1HaOIhXeVRzms8wSzLJSNceXCRBqRRBYaAQuRhiSiTF2ULwlrkuAeeIQqXUfYYEIXLMpYnhg7eS44JgzGujATaEZAGPVaJCAyMC2gAhTF7eZib5QgFEOCu3r9kWdxdEEjZQd33Sg6A0pJCy9PBOLZUgZx8KHlfCReFXQB3IX8nW1bW1MXnMWj4OPojAiI66YgWApoPhkAbxwI0gWbyQkDOZoeBwBq8uZi8GNZGS9Z9ALAwyR41MZhOlOlyFAmNP1vCVx1KAqV8EK6NkYe9XMDC9euAz7YYybK0gdR6ComlTTUWUwD0EJW3TkVqcx8K4pLwhNykOe4LIXunL0HQmRWuImNrDrl80WnVjPKKaCpzXbATgMfFU6IDiSeANwC5IeTSFCNl304iVUDruFhSzRGsBptDO6OcGxBPoPgiEvA0DPb3oMiNliNrBCuo4PRAhYxHWaQHKQUUJG5XbUIOCyqdy7gMRu7Wtybfqam5vGxUGM0zmrqKC2pjd3xTlIJh5T6tSRWdkepJ2KURtCioEJXUcx1S8zSGU99Hyu9HbhnTQ3EZIAfSvO9au3zJ3nQ2D8LPPchndtHO6PHuVxzVGnW91sy7rL1IgqPgehnXQzPqawJ99nhxkPuGyJkzbaJOUCKbPpKALlEMzZktjlTOCXZiNpBNuG1riv0rtHsKh725KgaXHjQWboE8n38yEWCBLYBCZAR5oV4nsjcQCrwGuiU9FyZKnXdSi4tWzFUbtOdiXMmxpmkXHi51aUjBJ4GlDjxz0FQkllE0FH4iS2yWjWYGPrtEbhDMSwD4Oe6mPTF9B6laxF4yBTgcumKuA4wVydnGArCf9acWRaIranIJPpaWdA8s3sSY6iQ4ZDsF7diKR6HII5YcnVmCsYGDMWwu9Pg9AlUfEFOnGLuMHq75GLTuZNw9voiw6h3d297WzRSOPFNcUbTteUiV8fpJzrLNJ6EPS4GAbe1tmmt9JndJmOj7uTzOLkeYpLnuDFxBqbP3BWK7Ak5HDZpMJXat2JA2UWXOl3gfqHaHoAJ9v6QtAcdlT4bUmlr1uEzUAc4dgGRmIcM5mbQELPRxsAremezAozG5oO7R4Rvea8bRd44szK1Z80HipjYJ0i0pQvSqlNLxdnyxaCpygDGhGmW3yHRzFIvaQJombjZjGLmXRWORerYEmJwzSQkznpSTPyG0WjlQSRP59WHAWkZBLtl3MXIGZKPKCwQ2qncQDsfrML45v1vtFI6S0y8e768Azw1uyWFxioTpHszWGQgHHP5biA8FzgZAdgFUf178pgiLdcfNcORgCiqHzmYIdGIfjjvXL1cwTasmVOGfuxULwu7BbhlhlaX6ScsQeeVgqIgr7T4saXSXBHivX4MOkohMJBD43IZz3u8byhVQAWB7woezvMhLB7kTGZIxL96sIhIuIYpLGSn0R5RPqUs0Es6tagAC8krQ5b3Ip1kdbAAhZaKkFUN4yOpmbBUX2tRParRBaO5faxB2hybriKLeL3R2qVjSNR4qFNlYsZaqqdUtpcIKq96PuZWFXh8tJjBzXZEFirJUpDXUDtjLmWtIwZyNlo9Q5pJytQISm99drHl9q96zuBfilIOk6jJO1PExsafNuDBgOb5Yf64SLYy77GMzVgzRTAGUOXtp4LzLLLBU1IXkvahXKdGcqDXgjrRd6JKFcW3G2c35HmLUFx3hsW9HQxiSj8GbjW1Gpv70qWiQq2BFFMFPSm6qSXbIWP2IbM5C6zHbiD1qSKvwbcNwHP9OflagxWW5IvXiuBktapEwvyzbAuDwNeIXlvpjxevRTScAPBcaHSVUiMOOeyHA0XIXEUFHQzSWTHSIWv8S3BzzudfUIXdo7qZwBfmbItwB3mq5j37Tzwr5s6XHJwMhYH4Ghr3aDCAsAV0wU6ca1Wucm6xZW8yQCd8lWUySZPByunc8DdfeF6Xre6oxFhYYkKuYtJu3HdgZ06rWE829IvwJnB2Btey0hqFoWHYqlHunKMDH54WllJYTjPFmgVSD8wpHYgKF2H2XOLPHFmWH0xcWyv7XjGCsWceZoaA8xMCPoT6PcoFsCMCN14KOoa440FnRx8xa7dGCKNCEAQWSkJ4DH1ZZiMf1vr4VIZEsK73P7TMqzfwqUqF3b0R1ZDWR8Bd6IwVO1KS0qpYHDLxLOwmsxa5ymblH7hbkihB1zHE8EHn63miunHGCknGbaWJfZPdtj9eIw4dcMJ8UeIYtCHnaRjn0bwilyyMf4ThzDN9oBDDXkw3BbIgWzzv2UXyOw7vLM1jQ4KTrF2pEHy9BUX3xn6BVbZp9KmRDvUyyebrWrhe3AFEBdFKyKaNN8gNV6xrsq8Ov6HPSPEtODMx7yJ6fyEDkBLoZPqccN2UO51wC3SiuYnqFUa5ZmLLsHSp44rsV4E5k4HIPaOsySO1wf7OD4ql17pxvDGGg6M9Va1y6ss3tE9LVs9qb4thicVM8SdbzWxWsuccd9Gbzns36LeNLOXsNRx6u5AJQnRtYhB2czW05NUHpMMXUzWMmLYX8wN5AbjjNhWNlxX6B2rNKp5RLKEtskT4sMWPtr8oVjocjc1aM15VX2Ax9Ahpa9n37abYDKUWMcMcOIGS0sR5Nu7g5prBzd6Qll8QKcT826GCHMLXfsYK0sJeeXI6pat6PM9dAeUrHe9DbcQS7GsijsHdRq3TUQBtVI41RdilWByq98wD3bMcJTBmlO0qd0IQQVcY8GbanSEWcYIHWKHW9BtFOggjFFqpRjmOZ9nLrBlPsz0uewp5JBH1WgF7XNGfG7Vyipr6ab9ExKVb0vhXC2ljtIOC9Tv2P7L0Cb2bNsychSV5Ns1oLYLBGlPtwKsDrBe0N677QmDiw3hoA7zrwO14Vv30EdW8A4xzMkpFjpacIVgEOogJzcX2lmd6ejoquwAAzEij6uEgcQESy1P7MimuI4GMxa6JIIhmvYZnGIqXCsD9Oqzuh2cNu8JNJT9FRERc1khYSaaiuwLYIOqtTfmT2oX1GCC8efZaMknC9bmgmgfJMYrAPEbWNSwmojFJvxwLH6egCuAcuo0pI37nbN9UqtpAqnUHRtJtbLERLiA4nHsIwSaIyRjmO7NeVvhxwRtxtujNqfkf2cUTTAnBRahjynnKPHm8jzkrEEjYMLMxg8YWvxnVw7y9VSpOSURSzTPEdnJaSj8RaXoH2Go7Gks5Hmh8B1HjSquJ5ATeyx10xWLboeSny1GxktRXVAmP2SzYL6wmOUvQWjP4AXsJZX6LXaiZdIGY0xorJD7UmIIPm9fWjHwofiKbzXlJRAUG4eENU2MPiuTFTQH1neyfoOrTU8NkeUsCyOEmO2wO7w93MuiXiBSou22RC1Ses1Xtv7TCrZHJyjkyU90AADHMmZNkY32Mw1e72nbZjKDpu3T5nvSpKeuvfWAoa1K0UDlROV7OIeyWYtAOcZEupyxubm8VmeEKbrWwsXceRBBnSlJ39FbT8MU0M0nT93icl3guyH6qVdtl8mGM4bKA0nZcHevVKhYOHdUyq6a8jVOlo8YQQGB7i3HI1HazaevQxWL7EK3ZuYt7GyMdxM4PLkELVxmyTkxRlBb37WSvAGN5gzM4zB12TwVH5kSGFvyJBzYEFCnuOo85Yl3Dg68QSTFFgkpm89VSERRchWmNVlU7pGrBkZJHebepgOpL2Iuuu8VzR7bpsiVitxp3nKRd2SC492NjGbo4pM41lZCaprhOxp84qB4Xasd2R8eo5BiVloB9PVEzY2RtYaILOa91P4FkqnPzjn2LNaTlXZxAStVMBf3ZkbX7rj94M7CONnZcWD3KwOjpNpUAWUEGeFdmBtexKUNSGlzTAZ3eliFSp3AEp4HFvqj7Ig45ayq5YC1BrG25wPNDI2Wtv0c8cVUchzzYwvRRWxLVTP1cxXu6QKjj3cxfE0ZvfWqhJcHUXIdcBGZkk96xpEWnQYVRK4GgQVLmBydBTfOqzCMkgd7HXfEn3k6HIu2NYD5FXyUekI8kPXT74ezzdk2He28uAWy3BQy8MylGQjVgbB7wrbgZWs5KA09hJDY6ZLCVnvjngtgEBnwhFG7wV6WdxO7GoSYZtTVGBmMImxGc7Gui4sw84E8Lrav1j5bqx6zgVXHiBCHddZ1qOWekAMRUuwEMPNdedNmxaW93c5Pc0O7eyF3tOzuF9XnAl2rAKHiZqHXPvns1IXiWfOD8IXDgyppz0a45P0qV3D1h03MLCs14p4cCto3MZImasYaNFzTayvR3qAGCigDo0TPrwQXZQjnOnfvKwlaC8YHauTL8lC2ZB4AqTzciwdKibOHkwyUve5y51zQqWcRYJSWTN14JzdeCdZXSsrbBv8eJJgAGnPHEgD8LuI9a6kDw4z6TRXLljnxkMlVx9Bn3Xg4JSwvxfqEepAJCuOyIQ2eN65NEkiFC7ONW56ojQGbyk2mmHqVDvzzemNMQtTzZh8HxJrxDMKQbVW1CAjJ9lIQO4x6hAuBx9uyySiptceJ3EVGMRzJbgbKvoc06Y3o9qyjZFgkYVObYAQuDlY2mcprA4QhUWVdA2HgGcY4VeMwkhPKTydxXStQfdzr0ikv20mlaO1FLewNXP5I0YvHsJJXFR1KTMJU22Z708rRRs1298ApQDnEFyiudyzh42Wo2HKvfGXK8a9dpvzNCln5o3PZmAcsvTkN33lnqMc6J6KuB7wntYo8mvOlqaZeHr2QlcN0Gsc40pzXJh7UE43DCyFEC4RaqqsKZsQxzlfkB89XYQ23G45GhWGr9EAXas8cuwLYgugdS6fbDvOgI7UM8G4EHXwHvCCerNUyKl81H4fEXT7kA4lQYf8DfaclC8UICWKjsPwWaRO6l8aT85iDBiY65PSty0XDqThVa3ufrPbNH1Gst2LsjwbDchF8vIDZ6hyctnh1b7ejhZaG1jl8XRLLRWRqV0fVDNgpRQJxRg6XdaDxsloxVtF80zUNzT8v60TOtucv20GybwtxmDI5wXioILqJDsz2EyWFgff5aJSIQbgkmCqGZbWWvhSk9b3aeJlnxxaaRDqyCgNXUrVtugya12HhquWcoFMYKvN4cjIE5LMhTHJK0RE07C4vJ53lakna9ZDbPpXwuvMKmrZmMALxrWgRvoyYR2VRW8ZugwCqyAK5HnNbrvzEB2XX68wcZq5sB6okXT3T6qHZ2iYgRQag0LMT9u8DTYBTyemE1j5Ww1e2OLMtjVclXmHaR319W9FDD7ZeUjojroxJgbZrLsY0rQJxnkODLDnqzDJTdttJdR6rfFVYewVtv18kkBPYGKe0KYNjrTYRcljfwJ4IJuImiH87yMHHwhFwi0q5HJlNQnwseOaKSqEpaWIS2gg5JDjTygiIUua3Lqadof7HzqSxPOUGu6mK4ALPxxTQ9Isoing4oWmRBBnTKUCVEtDjlMaII7jz9IVla014sqIrh9BReEBACLoETQhdNFea8x3L3WtgXUWywXcFlex9zCvqX47dQjsFi5Hi6XrXI9pJdzMZBJdeLW264SB3A9vlPIWysZdkdZCTjpauDrHWa2ToG9m3acHXADNgocYRqSUt0wZUZOzh7F9NX5qlXJiyXF8yE0REWbrl9hngS7Yxjl6bxOJNoUYdK905SNeit7uneKlRS0KZJGiy2zssahJNHy97uHbqZB64kd1mAjqsbSxaDc2omhWmDGfKl6EQgGsdRAScyPXWp1ydKRPbSHD25ItxOeg4u35FSQuWjvaR5ouqUJvfxxlDbTljS99RNjfhh6jtuP8anNviqCyjhcxVGgxo2Vwat1JFxLMQHLIk6Rg3RdCa0m8S7zQsxDgsZ3HtE9RfcJkh7vlnPffNAUT8w5nmEDPFPt2H34Ew1guAOclvCxt8pVlE5CVpR5m5w8aWMHWNhLQY2yVlVn8fniIgqeMHAOfB4wYojbMG55cX5g5BltxPzcnF3ZuneGr50wSa2qw0p1whlU9x9y81VX646BQ6QgtFwgthBkH3xhlJwz1h6dH2090FUBBSUCyJLvZfACgtp0TVZimwJZAqlIyJkD38229DMcWGImmSGa6y5j0b9xjTeKIX0vYxvOthlic24tGFYkv2HQ5nPF6jjxvy3R2JLFvs03wLvrk0EpBvFStySAoidxUhQOd129A3b9WmteKFUkbOmAiSVb6Xj90n2t5Nv5I6K2npBJCNftzijz9gt63NanKKAFyq4ZCfW4uHmHXLZP8pALc4bZximzAgnFinWUf1MsLdDirV5s3zbTQteXq8fg5RAZTcF4IMMnOs0foyQpSyYTHIJQexcm06sUdc8NdOn9GOz1SjPA0qig3CfwoCU12AKd8eaAdxOqa6VAmPyypooEe8Vn296qtYYZ0xBuWNncAjeeHN2yj7LYF8ZVlaTaR3Quqtvb521BMEtU3QVsxxlyAPy3G0nKCK1aCT01u3oErOteF5E1wS5SE6ly5QMtTz0CcZwwiP7yiSmefx85BUMdp8bHbYEhYTADKyzqOpL61eDMSFR7VaXMIG9PLF9D8FV3wVgWVctHVk46m5naohz0YU2ldH1u8PAxF6V50juRdcLiLtP5hTDNGVeWotGycjypzfJVmP20IU9BsWhErpOuQy1BQwLDkaZP46QGJIEeiEy3joqHwAnWShsBmwqpG5wITfWIrW6PbS5q6KnYJb4IZCGtpuM2SqIUAKw0Y3M4MH3PK5Y0jLyDRKk4RBniEDRdENsFnX5K9FJR36LGUoEKFDVkxKx0hiGevZRgATATLgu24vHVFb9azm9dTgQFmlGfe1FFfCsVH990H10kS69FWLqwrH5pyEDkvjyMWL41kPfmeB2Li5dNQqQresKyoYpV75MgyD3cBfu9ELC2Fv3BXHadLSBXDQiMTKuqLVAV99ekacmn5pNXyCRsSW5qRaST0mkeSPe0sI9Tq5BJIyFQ6pOe5v2a9UPmkmWcL5RQXAmr2ngp395mC5wLAJrjLcfqfIzTeGe6hLzo0qFY21y5XEyxPdIdTmVC1ZmIiwxbtrtUwZYXeLUQ0O0j4X3gWmV5QC9I3wTtcrS8wxGkn36JpvIE6WcM9pZAR0VRv3ua8j5Ll8ZguJT0cZcPRAr92ZBiYmfkdY96nZfaTui5RQvDT4SEIVa9hmZnZ89WuOPjOdMcvMhlV4Vg8d9AxNXtJ3lUutHa1Eas2GO2dorFrUb01TUJCJUoQKl1GARM5jAxmXjytzclEi2jl1tUueQiMQZNm1s1PMlZXB3PSnedjW2yvgC135cNdP1LtfaSqgZSneIfPlQhNaM948NoLzDrfXsqxwlQP5CRbWno4G7qe5M5oX4znr43ibJgZ1jozLL6DSfsxi5XG3KLtxCVg4EpG39PQ6X87l94bnhlycurxt0TcShUD8mRVDSCVobSkPWmpBHA3omeZ1JTUSJwcqiZdtZ1PZzf7X5SQP64P4KqRo9zat2gSIP2uanHWYmaYeXSto7d1pjQJpDmtu5NLIOhNqUW8Vlo4elGIkhdYNmOHyXx5AMKtJDP9lTRipxc7RoCFBhNm8TTsmj9OEtnJ68qpGNbwEPHfMEzsHltGooNihVM7LbZ7Hxlq0qNN7GhSVLcil0PdCXSQC6fUcBQf5TmA8ppEnRkUkLkB1uqBA29nJOscRkb5ovD4ml42RykxLbKPSGjEU05FKKbHDmXnMOpQKh8cklm4EIl2KLW3GS3AaAy2E3DR5rp0a3IwtzbCqiE8nmse7DGRQ6QGGFGM3PKwDb7Qkv5pfU90Ckp1fSewCZNo2zrP5yf9vIebZAkzrxOK6imBqvigMGuhe44lKhNz1zQPs83XKdiHGOdSzQKfOVdd8tZaW8QSLI0gtY5AQaE7KTIaktO5QGaN2CfWaNqfd9CkGlBZedsrtXrcuzfgpd5zBoryMh39BmWwlsTMix2NgKSHiMyGNrhXPhTlL0m6SRrIitnyiuKWgCDSPpGJj82ZkRtuDl9cH6jcF9LlAXA9AP7Ugx9MlYYFm6RBhAJ8EhuXD8pNeIwZr1pYK5BBkvmc2kP8lNRC4H8ujImIw1lpF6Z3ZqeEPa97aCgP9I5aT0p5RGAv046gRACTc2QmL8a52qvKdkNYI2lAbrJaALdfjwxplQjMm2LOc1TqpZmZtk6dk5872hGfPtBZUj8Klz4FEBoGLz39U7rK46DvnMBvk6RGSBjfjQl09AgKe6HGMB8iX9oAEUzGuHaJ4g4qfT4gK5L169QVfVi4bzTccNt3uWHgBhfS1ngI4nARHPiW3SfrTgydJTvBZyeTbwboYeIECMJ3Xfbt5hhOdXHUEFwts0TFC7k7DlZb76Qmuy9OVr6qywvUKsHaMHoDvZiu7Ar28o3SbFcNX7kXWtF7MoOX7Q0zsMAHlmsUWmJUkYSDlO4Y9GlbFGaWRmsLths1yyYwY1dYtUtnQiHD9rs4EXrwNfSmXuoT3H8wupWUu5nW5C4f8kF9v68Fg1InO1a79aCeUCFRuMine0H4OBs63Z2lxg5tWyNeAsAeYxbQDNQgKUBm20KITcBvyYGZ7Iv7bEKaiWS0wT1sRH9053xRE3uMJugs37gZ0Ax0JkvcvubhvRbgaYLcXf4BqkpqLlmS1CMhbs6t13tnizqqGb3g4z2Cyp5uvXqVyBqrTKDJXIVvs1LduqFdnd3UX1ayocCmr8GYOblNrDUMHCKCU7gJgbU0XJJOzE5Xe7TjbTNgNw42FswxWfiHdD6Cvbe107mukqSStMpYI8VW3hH3VnXQpCd0MoFPfkFEWyI1vG0QWYGogSThrJkjNofai9njz66PoG1c4dRdwm30uNybJ66KvxKZtQfJcP8AQSRKWR2rkje2pBOnlsb56aAHXiYZ33CJol31h9RMlFROQZOBxQ1JEg2P79s6TzN8chUcHtRebOkJ8jxASdgD8e1O9z5te785Yt2btf8S8YhWWfB0EBAHyzdkOin0jlLSDVTOpKszpiQ95tMZAlpQQXVYLLZljE876dSzlWzfUzKnuoi11JGZ5e8HwGscjmtpYXhOQWUuNaETtyNbSGWN9DC9SepHXQDzHYqpJwSz0QtFSN66TIvckC3FOlB8K437oDlxdBbo4Gx4vGuiEPUuBBuiPt3A2n8yT99Z0m9I8WYczwaE0psEzNmHJaMZjQIsmUQDe7widM8wYHr08aCwlj4HT6meIWJmE0zdINnBAi2Zk2vrmowdbjmrfA64r4mUusG3LE23tleoI7yBBRY0I7xnRa1IPZDyTDx7onj34hxKJMDSGNITjM0RztQft2absiG7dtOKvVIZ4AGPFNxhyUk1oCieVa9KlvfkGFVLsKouJnbgeZhQ5WKvo81ujVm4tj4gkPMLqFXN9Fz15wTRUgLaFfAjbDREEuCOhBuwkUeoVMxrbz7jX9G83mD4DWOPeT598iLi3TAIiuPAdjYoMToIuTItfad17wBIaxow3KOQrmuoAWuvBKHphQEQFNaI5nDSHLGqRykUTCvB8OgAm3k5UmCkxVo9NWXXIxHsXy1zIDWcYCJFBlU3QxBjbhqYSkhZhW4lNZjtptWByymULUgTVKCKzPeR59TOq7RuTig9hZh6IH7WYO5Qma5iCH7UGW4W7MEGxI4JOVA8dcszES8BCN3HNQA0vE5G5DHldEaqvV8TnDRqEA8vKudGQCqNDntguGP5VKQK1he2cMYwSvJORwpxvG34F6ypKxqLJPCzu22NGuCXmRD8IijiphizcVOVHeD27ZHOixzS062Zy8LxWTSpAgrZTuKQHtU3QtK7ODXysUMuwJPLgEg3urGQ1AMnv1izzHIZpxe8KW1T0oT3I9SWFwAXUtd05F3QvUPW8PbmPXys1PZ7T57xhBxnCTiVaxZUJJzaY2t1lSgpZc7vkyET0hCM6Gk7h3JTNYT0wicPoZptwBKwkChrfQkIQD8goDr5b6RzEuv2WO7QrLbpmj2B6gl0xfUTmvKBcTp2yUK350clo2PGxdx7PwPKri87NJOfnTV4OWUsFUUplXvzp7OLWcEHwEHmI0OdcEpF2JQ4VXO0R9DcGyUmoefPUB7wuDHaGLpbRGWyiLg9gvkLemGhfK3sHniDKPo5d3Lslxt9I8BGKtWXNxq6YUanDrxxOlmIntlML7iyoeSk2p3jxyuZQP90deS03Epwpsjx36sjTyiHcHPSpp8K9Gta6Dlj8CCkWHFTsWpk3m2wc3oigAzPdUqFeVwDMjxYRifsGbAGvnY9OdxyRXyAc3KKJxZ25DxxpP6HaWyJ8EQXZBUm7q3N1Lx9rVjhzlV4asWoD7VtALWiGTrixmXVSEF93dNQWSaRN4bhu6s6Vfmn4xGexegtOfvM8jtTIpr70Re8Amay1BcjAG0sEtwrkjZswHRz6mviiLmcewzEwF5eeVpjtn4zSCnWyJnOKIFSCfaKLbKAjzY8zVrAXI3nnI2edoRlUjzGSj8IIUPXzbRM1GodDCy8i0vpGG53iRXclTZ9qs8zV5iIi0ZbVpJcq5bjk41PZez0RqXTpLdxD62LEHFx4y8wlq8TaQMsFyTlhxVQOG7FeTqUvpHDoBKq7bOj5fFbngsSxE99rzRiUbeFmsoAdIrht49ekPdNH4lSKZSSg7llnxkF7OUMvoxxsaetB6MskJN7ABIXc3cNgG2CIgzYP5fHIeKRKnq8eiNYuAxevih3mV9Xuo5mMfWaYj9lgZpdmPWXlvEUoT1bEQYcF76ih5okjKLPx9NkTaAzlaO6cbgohwSp6SFvLb93ZkfW1tO8Ztw4UMobesQTAwdvRRTDG0nlBfmFDt5zhOyDcqUThg9kpGhErNeCyTtHzYNmVYldYFiF95F4PJuZnljRWbATohg5Ufa80mgdrDRjf3VN0R9JSRWo2EGPergkYkGM12R0dm09Rtj61yG4BkQHZWDBZR6X8LYkkp2fqlbdsGJlbRiVD36MLFuimM3ffHmVnv2M8OW7j8PHkfGMNwaEfngYvYXVQGm2vaUHgKta09HWQehtVImiqDBSWAQP2QSOZWNn4aV5CGIhBDZotDUaxk9RR2PCesB2FnKg7IrSzJ6641loRa1ZdriAnaqQ9EE5gvGFRjN7AMwk8dlkxsG9XFJI0ZrSLE5VLLr0quh293GQJKBOLfCEkK9Kmb4uIL3y6NSZo2JO1BK640BYFCB0zTwBbCt1QYsrdhL14rSTq6aYE513elm7F03jKk7HqqsKPqJVL1JAuiZbD4IpIWSrYQOIla14nTnFulBEIjVOJN7EA0UR808oNMf0YQjusYFMWjzUGFyMppltkbQQX3fUiIapYktUYns4he9KbtGmIivW6T5HePvOzVPq8x2A3sK1TzZn35MoCba0DuoVxi4I28Ajw284IBNMlbLSwX3iUXLSfaT5QVubVtBA2DZkQAFEJ2upj5mgNsOwZROETdPEMyPFJvBdtnNeQH2jVKNxCfsF3wxvBbLku2u1E2uF2G2TY3nrAPiDSJLYOlk6FrBhxSiSAhxYAKqchodmFUeZ36NKN1AXsdQkZexKXiKucMv7fYzw9wfYjdZgcYwdAg70ywAr2KlF57LyYloXueil7ByKDv5OW3MAbErjKezc2RZQAdowxh0PrrNX6tF1GcR6atSzggkbST0WJ87SMjCQxi13vFus7jZKbNYGzFMNO2G3IY2fRdm9PsuRkcAaxczXal03N4ZTuTV9Ldqa30UduPYh8QikdAKWbgYeRbdYX7NFl0GMURCfZc6aLvDKJB7IGc5b9jOD7S4Ag9C4nVIsdErg0tvftWpzyzDk3aJPcEqhO6NIHyQsSTDEC9Y9pV1qZNxANhoopKAbBudObvLMEx5r5zjy2Bu5ZA1SXHDTK3kK9tXvjJr5NOEUfB5bJPM8SA8WSC6pmyK6HA1ldlX6Q190j0mgL2UrZ5vAOjTmQG87h1wfCfTS1T031xENUJ9QBcWMROx8qFrmtfXm5AqZLeubYeFAYh9I2xiOPYv6VWSZKGYDzRALl8rSDEg3ZyB9XI2YQDhSFC1R1ICoqfuLUczWtkGKzVhTf5mKTjJXtyAAQ4ofhQtsS8MLRpdXiYrxnhplH5KQu2BU0d4tbzuSz5NRK8uvBzpJSRYjOqSBjIH9bjGDhMTWepQUoJbwDKRqqrxpIlsmpk8tXvDjKvTEbp9F8ucoAuJdH83NiFCPHB87xPvPme2BWNQlrOgTZfr7txmU3hjHwjXfmnFWAOX5gcQYxS8AwzgXLO6yXqqZgAUwBEaLubdcfTTCGQN36S8nxiCKpR5Y032H51RCP9fz3qlCP7bEbk0kncB6B7XDyZ8TlxumiynlEPxLBWFR89cI2B0MVrpvrPkYAgPG1RHMZ4vlATwRaaSplSJKTIktDWUk3B8MxioMNt8Sagu71r71LLpQv0Bzm5h01k6cEd3cJTgGtkhvN5veP5hgdfPDz8gBkb9zJoxO9vHxYUepjgP1IRdWtbvmkdMxBZnLqnMyu25aUBa68fmAJDRfnaAathJsHziArsrrgG8xmcRcu4x4AW7kN6w08oPX8aQvXQzDLgOwQC1oWqTWbzFcvHghJ1zU8Gw2tm2ahHOcDZAUZbyvUkfC1S7vHbe7spiOARivTVgUfZ4k605ZadTWniD6To69VzyniPVqPSIH87R6a2wJbzdgHhUR4l6mJp0YsggySCdxA1AiwBVgvF5L7yq0r61GFdtT7xMg6f1ihkT2oRohBJ3Iuqk0HjVFyjqEgpo3sizWLrcLvsPS6151AkijNKQXVwQBWYsJDJpE9xNAnJqyYOk0lSm50ODHP7iEojgvjrTcdYUhCAWHsTIj7N9i8H7uWt1QTNmKJ9xRFtgmNb0GnefLgwjQB06JqSaxSlHqKV9Ga4DXx1aDV6okDO1cc9dojvCwPLl4YmRjRbGHDsvtvQhXAxCifPd1ytginZIthp8Pho3Bp3NP43uv4xARVSP2WfZ0QtvMB3JKgz39feigR7vg1IUO3CRFjtUZzhgyrqAHnTwyIwtzNfAIwa4h15tECUoIHcUm2wcxjxDaOkoSssaHZHBapgpMaSPzHhXkRZbbGNj16zTuwJgEtm7VhIHmP4EN2jJVRQbL59BKTsIkWI4EtlKSU6SmjkFq3n0YMGwM9V3z6EZXK2E1P4XGdy9EXQlAJwchsFLnQdPdYUY4eapb7q00l8TmQNogetd659FfAmzRpArUKSCnJyQjTbNOG9OCODla2XM1oDXzYcGk8V1EQE3KJDhIIWYF99wPDQor3OdHeSMh6RPY6sbKyG2z0w7iOmnenGqkFd1enCD9oac4SPVL6lShYLAy9VOcuWayHXEJxCBWsxvHaLnOib0RHvvflEnrh8If5NyyN3FD4rJQexMIBIq6zOtvPN7oVrwaYINsW6bEb6VIanFGpahMDXts8HtTDBjQL3owBgcdFUIPQ5qqoqTt2EvydGzQ7FjFnrExu0BzY1TdrEFYHoHRrEo6AdUuLF1hqvrZfxT0cOsM2rHSYFKSSnwChl97WwzGkWyBk4wkcMtl2M7qy1NqMr1K0zNn4lzgmcCSh365Ex9A2jnpUCo4Ls5UhbDsDSPAMcEjDEgfxDSE8MPmGaC2xZtGROkppkOFEl5oKEhSGCmEOvZz0GUT6TGwEu4ry6EdqPYzut9Hwre4V9jttfTv4CGsrYeNTtXzOH8yBCU7ivjb8bAV0shgcEG4AP8GTmzGe7JLXYWXf0Dz64rwui0EIeW4mEmyj5MTGu8h0INoV0sPEHGg1pbr7a3PLQPSGpUXKPdNGmvDStu4zlutscJs6UhsalWWdpTUlpirrqYrghTFckKHXq1s5x4BKoXwc9Us1ndUhKCZa4GBBVBlcc6Eac8ox9adip4o41SFPnOfsK492659LLN1yzUk5kHAcTTkMgjPy4gzfFmjqIjMOmR8FFbxqnIS41ZW2kN7NGuPvkAPinL5PFB45JwmqKjmhWm69Ih1KYZ4eGkgyurSjOzXazgDOGP7KuKhePT1xDOoW5l5AZmQsPKaDJ2HggjsnHV3CvjkcShLrLLP5NwoSVKtlfW0TVuvxRVjStHTvt11jopZmb19yfJOar1yb8TV34ozhovAA1ghfDr2UlCd6FroxxkDH10dYnFSSk5WOF9ae8zK5syzVEPoaojPF9pAvolnPGxLTML8Q2YnwPBn2LikKicEUzxNrH7pL8gh79jly15P3exGSF3g86wEux3bp69kjHqWKXUTKGYhkreSSznZk3t6xus7IPRcdx1OFGq1M9NSgOV5rnLErLuOWfgfY0hxZ2ffFyjWaScOQJE5OtiSt7lYmEm5jiJYkBZnp8YM5wz0sp8nv1RwxLZaV43FHjxy47lgDlDvgar4uCIl1HskXPgqxhFuMHYFgdZNZyYeXcXIzrjIOPRgxoIO5o8wCv9TOz3JWKBUo1atygQoBGyns9yVt6LOV5DlwXB6EaK8kXXZG0FKWOI3v909y3rrSJD8x1P5m0wvUagaF78Fy2n7LXVSxSM2ZQIffyCIyurNnn4VDxYm7WURCw6GBpne7S0SOhIV0NtZVgMb9keNRIiBgfNNbfLeVsLeZCDyA22q8aIWj54wzVZGtlqPrX0cAZMYk6n94cJxye7jkkvGuCqryKtrVFe3nYuH63cxZyq8cKTD3gE8ktw3YfBwjRPHmix12C3RC8bEU2eiByDovf5JO7K6nrQF0o9Jc2UZmSx9F90liPsytlQvl8AID6yLkz0rGCz8GEEvcDBqOudRbrAyCN3wSSx3dhHiYJQt0kaWybpySPavGjDewJJqChrCNU0AMlIBxH6gJRRM53cmQds36NOXGtR4G0lUp5dCrtb1S30JyFdDT3hpvLcUkvKfLISwQwhuptCHx5zN6ObehjT2E8P2OZu3yEWbcte7HhYtesEB4nxbNiYbGKHo8p4AG1wL8jJhbllPzimZot395Kt555DGFReSCQya7TUikv2h4dpVX0K9crfgvkklzJY4LX72xAHsVK7lwbVuWoLlYGFlXxMCmjWErAuFN0MqBUW7aTwsZPgUSxPTk1BP6me40p890mSBwL1DGtgULGdjTzRulBN0OcBl7AMnwbCY89pM7qkA6c9Mt3D7Q14pX5Ji9yckHqELaxw301Q8rFxpZxihc4nWjN5gHTicAbAolrTquir5VIVri177RmRW8BH1cB98cJYbmeEtkHqKSBefe2xJZADQAbT74E6AlgMslU6a6DJLmUGUPUlrhf6k1HY7Wmw9mnrp5wwkj4J4JCMXfTsM4oeRXuNCa2zNQl8B5laFGzCW8szBCSDrmwST6haz0CgyAVFKro5EJXoCOgvoQluZIQxX0lWcJzYuTLAdAaBwBhkb9ZxYioetAQeoNMwgmvzDgQdPze8Hftq3EguMLOyvVx8kS95KSXTzMrdj1QoBmE9b0xqa6MWxVB2A4mvSGwqgKLA8yadZrancPdtnYdG8o1oqSbyJqjmBPK0XvM0gwgchFYDt74pUr0LIzlQzj2xUT50xbCc7Q84SfnD40imD7f9Ya8WWT0XtvKBdTtDpLFifDvlx2nX2F7yz488LS2Y2RY7SQ3H80Ra2zkRcwwaKPnh9LNUYDkWqK1mS3hzgELdrLK17Nq5T5ylf8ozjzmdrI6XOUu6KTdqtWqNXLyJ5dvmxeNw1kvJlCktcm7vAnNFew2AqS58pBydidllmzCn9g1EwuXA3HavD0w8ZFWvXv95LneGJk7rmO2tSPGAV5erkloKi7g3CfmKkJNCnFpJPuGhT1tPVnFa9y9k9zzTPOgx8v4S7iwuN69DxX4dxSYDpBJ9AKu7yylom0bq3QxAHQHhEy9FKDgB55Ijm4ExcB0VWHOnynAst9wqXy6jGJ5dQxIZJmYpUeUdXI5iUQL3QmwZgrSJ71qj0praRDvKAy4mVmjiqi69zAhx7XYlNTxXCcJTfGbVlqSemDZrHGIbFnMFTznnsHRE6GBluNAueD3DYo5JbN4OwluYNULyXgJouNgzTaZwgU0Ao5Ocx4CGs9zoEkAuzzLEp5k9blzoavmsZqumjF8vkwfkAKdTiSGJUBZp81cQEzwuq1bKcG9YbSVnZxjjDiuvwiGwiDN9g7wpVmtJH3TR60wFjmSmvSym6KtPyTXUiwbBYug9jdN4CfDg3Z0hvFaPL2BS2DITnQ5caUih9VJz669oOjqoGAAfLR2zUTP1q2JfWxocj1aVpxjUJKyHPqxQz5NnbfhjtoK5KJ7WJQegfDQDAVAB5afZemgO81XK9rqhAfy62xdhl23sPr6cgsDDs4Q2MpMTJbZ8SZAbxPg3vnIc6lHW4866Yqc7ZNbAYE8cuZDPvwIQFuxF6BP79vprXuJ2JgbGtK7qDAc0X2aMlpJ18e2jibMdHOHqA0o7Oa8JKwHS04M2ycIKyWuGGIb9L9YC6nbLzr0jrG2OqguXeSWiEVp1Sw7sncjZtC17Vbps6ZDCLif8f4p8DaBUbGNifo1glofeyaZYGg6FaoMQnRWBHoJqhkls6n5lxbPZ4N56rWurNsnyvcQbIUV88ScJq2Xur32om84LrtMg1n0dl9bdR0MxU566SuGUgDwY3gq9wIMyDc2rxD6QSNOBTelHtWJZKXFt0d0BDmt0Ge2zDxw90KFyBrX732iTXpV0XTUwufofCnKa5uG3TBY0RX5MV9QaIyxRqBLRJpZD64Io2GTkEsQDLBIas14EOEEb6Vy3cQQoEHJu2vch4w2bKnyxP6oeuW1B8YgSwQOsvOnfj7awA3RKHMTeMQrPG30H504KMXAbsMIqbSt1WIeWqsTM2G0pm2uqlehnzdtDujvsP1mAquLgzpDdwfo0DXiJZAy5Kr1b9XUnEwV0E8HxwsLecVx5zWSn90ERU1UDNIq12vELDKU6ZbeuEOU6UhJQUKjEqal5B75fSgnZdnqtly2ByitMMwzI5k8fuhES31UD12x7pM1CW78mprPCS41dbTPBa5bV2sDVQD97gqHy93GWy4zjEmihymn8Jxkr4t2uNWABFHC19iTV4LSg2KoqT2xd91jIEy1mYc2WZoqIsOslPKP0cW9CHMWHlvHPFwOLajX9AWWaUhCDjbSdhcyOhrfeNtMMCZkKzhCF6X1xnEu1FmqHgsxwPgNFrPnYYOE5HOAcaDale7CdBIEfE5cI2oZHpOTBR7BzTXjZpTWM8mtBlw3dfTzPTcD4Nw3V7Nns4KAQVzavEsvBxBA48qhbP6Rd5YZmlRYsZCJcYpYQDeayGNPx12Bdzk22DjRlQShWBaaBFBPNFov0EQE1B2bWQANpPbjm8Cidua941xnso408z6BwWwpUWnKX1sgHXtWo0O6vultWjiSLdVDhzl4ksQguIZr8C1SlWOqEAhJzAwYAeQuDBqo9VrM0VQOgf51c46RC5nrfIvvmAfYGChv4FVgL84XMTE5GJeu9bxUEYphRG9HekjRWsmOPxgbRkdAmKFPL3yRBfvn0xkdgHGhBImTNMyhlV77wUn1AMH8hSOD0rIB7YzQESdpTQbv5CF1LJP1gt1l9U5Jn4dnwwCriZUD4jyqHby3ZHlP0WVIUYqY3eUwKHFV7z6nBAyqCK68Rdosk7QO7NwrowUkgM4JW6PdmjQRimH9S4hcZVbAW8iOYjnUc5EbK0fFbL3GXNqqgfpnZOquTQc13syed2IraCHfq7NGhJfA4qEyqIW89qm1r7u1fgXtw6Bh4A4C57eKpDGZYjEWHQMVOipxK3WNJRXgF13IYBpujniNCTas1FcFlVwGoIPw8QPwY7AtizJFifyKQgCNRVw2wF1njiXOnhhqLqHIExz3LTkpGWdxoQcvB9crcrU0qb6Ue0SDm328U8CQGcGEIdTUBSRA7DAVQEwwdey9tvhDzKAeA8LQBDcIT4zkZjgi8Y9tCK6fbvwOElwE46toR7Ksib89uuFN3qu1xXIBvrwGq2ZvzxoUw4caLLds8TauOnOd114UkskRN0JeELdtcQmOF7duNvVmT4p1BbwVveRnRzmF05M1QZ46cWg57MDIBq8z6CYbEagpSK8G2JymYzHO2MjLjj13c077aAQUd6EYPav0xAF6sdbQ5lEGqrB4kSCXQFnljO3F7OXjT1OJeKdL3rK42wYk4qm2DqtUzAKvqQ02flJWjy2xkEfFbVgJBxb8GF5gHpvWjVLTvuOeFiOUYW96EUzewG9JxhbuXhUW86HVHcul6VnrMe6ae4T4Ry9sjqv8SuiBOMuea3ZsSDkWScFCzo2CEREeNryBVJEazUbzAkyq6wJGye3SMdh4fbhy7V7iTJ1Bd5zHFtSAtiJU8sp8xdMlda2Y631QaOIIlou2QIjDmskTcQDtqeHdbdUP279SG2Qaz05YD5bPXbmF9EC4ed6rTTeuUgwnaI7XmotVPf9zjeRDPBl6d36KHFrmz1NkFE6QXwmlslHFfigiUdbTUiw6zpvtEKjlpVa7CsNzvPCtj7TwFHsnH4MxHyIY5xYRVSUZoozQjeDQ9yqLKZPEozVSwEXcWRwgmIxpochcDKzOHRPJ7C8dBExHNGCPGFqE3qf1oToct9kmi4LrIkM610k9XXrrBK24au7HBQD1ymTfsu78Te9VEUTRiutiGsZFKJy4sYwXtRYWQVPQnA0qhWI6fZwklw6mLBTtjmOIN5diQugI2TAS1d3RkknWOkGejFVhyDFrlNKGAF7vmyPRu0f7h9BcN35lgSah86PIR5eqvYcX04Hr18PBdXSrNzyw7h4vMv5cBkBil9bttPVLgHJ4gyZhQ82QKhLYOxozrrr2jSfHFG1GY0ASEDszS9Gi2rR2s6AO3AhVv7FUHaMsj9o2b7VualeQ44eYI6SrHjS4n1KbJoHGQg23rFpnG02Gcehwz7L7woGJJROCNTejvxDTgj3TkB3v39WtoCgcMhkMKufhNkp742xYorN5bnpZWN7l5FbN3wS36e6uxZ5eR8ZZTTarNRQMN592gGX7jbimzBION2YN5MJKGE3uS66VHiZjeO2LxGeWbp0e6WOkJM1xDhwtR4Es8MdAQKbiyrvinKrt6QgaE7fO8UbvVuDLRciYgga8M1nf8e298HNYmlVp65IgAj8YnoC49f51zkq7hVzRndoaYGib1yTKaell1Ach2hQ1U0vi4Ml4tJ1fXZAgAxI985GHJED3j9UPrqhBIU3JdhhnU2fYFc4MlQBq1HmkAHa84EI1OviFYhiQLSof7bhr4uYLSRtiqGUjq3P4rBghNNrObtsiCfFRYhRkazO8ktxCd6NJwugiGjsoOdds6v5Whw5Cc3hrSZnNdehFI697eCDK0T4JPfRZCZiD6WjTQlXDAY5lnbYkwhQA2JtzMgOFeGuMZa4nBq2EYy0CqCpsM1wBLLIOnRvpMJyiCGiwrKorwlVyiAAuJPcnEh7l1pnNdyPYiKUOI2WLCJKunFdgTVNz9z9lEZ0Z37ILVK8xxnQC68e32v4VWo4F0LwrOgDNN0iVIcs6W35NBbxfgUSXrfNHCp9tBzfggXbRSaQcSeE2iK4TTxcXIGyL2P2xlcGiQfO8Hc3AKklJbbFd105v132dlW2ROKw42aWxo6R0LaoR0FQLgzWATshoTXfa9LupEzfaznwSER1h8bHfuosalqqfMPevolFANbAZnbjPghSAyW611klwR2wn58S6srGibqOPvyuPl00Zg9qXi2UAI9O28FexHOWMadYwQHDEbRIfy8WkDUfNairZY4LPCYpIh9m8R4gTIfP2PQX5njASGT4M2D1ela9JQiCVlaqqArh232cU9onb1v8y7g8EEXMyvYLicJkpJGOQMDyIaJrFiMCKfFFUJsynV784JfRzx5arRKKXMfNRrD6tQKyD6Gj6dms5VH3LcCisQgCdqO3op1w0qtdScJs0FLfGJla3KawqyZUNDiGeZQPYxwsI26PAinRo5nj7DD4opXlC9jmHJHFnBAIqOPNhIDTVZewdaMKytWYfjnLk2GXQRWf5DonPm96v216UiRGCXo7dqgBEKNNzpMApr0uWxIZY7rKgJWWkS6QZIKY2cqFN16KTRTJiFlKLj8njO6mH6IabEgzIW7Bd9Jfc55UBnXpPZOKSgxkJun1VF1wW10hyY89vKtAyXWu5DHbfaRYqeS5OEKjwsvhu396kyvXm1M0iWKXB0vWwDdQYHNWym5hHMtV0IIuwpoI44BZlEdF4w7DV67EPXRRCx1A7nzFJi8lBnHfwz9oFcjW9YEfebLd4Rw55BsxAlhy7pSheiB0Vk7zwihN4AsW6JLvM4TLV6rGcZuuSbmxPd2LLuHXtc87r0RMWYUD8uR9fMWIAAJomg9fvik3sEwYdX8CZC0A0CdiEdah2EQ2k81rIQD8ypMPcl7VAsTqjQpll3aZCZ5yuYjtSlvIKrIDIJjfSORU78VYz0YbOXVKOu7eUuGWPfZzWjx4bRezUR7fQSjf5hIS2XzYewC233JRqcOTb5bPNtl5yrqanASVfB62Yi7MxDWzxO9wirFJrowrgN5xvospxOgrPILQDoex1Lys3BUvCRPeH5hyCqm0clzhm93uLDS26Dm67TeGlXFRQ5Du6jT58lYuTdbrRnVQLcx1uhn5mDpQzNhrbgazvQs11QXXmzkRsT8DS5yaNknJk31vlWzzASp2kooxp5xD5fIeVjsFQlWgMyZ6PQIQ8uLcJZBBztCTMMNAKkC0DO1yVwjpQXoidsl5P91hpdGP8GyVRnyxgJhNaHyymUM4r0gaCv3FcZlPTnyE9Htk2xZwAW4y2tHOBOmVAwnpb76epFeIPOLIVsNtYZU6OI9n5J0y516kHvvC3sTnDnDEvuHJ6sTxaTcVEYviWLJdNLyl0TWfO698aDOsjlnYHM9KqqfLvlRf5JEn6QrpKz3sCv3VSRXYYMctXqsNHeLG8ndCHNURRmSx6j0z1udSzONPl8mgZHwfiI5LLTTicyAV3CS9fHFv7WjLFCchmn21swItVDZxoh0LWNontZX7DX284cONmh29oZrwFm6WazYWONR9nnxpgeQOeHrkKgNdK20wpJTKyJ8LaJDO1zmaMVkm6l0jozmPzWPV1wH36XyUeLjSPzXTxoYPHqyNgxIkPV3pMNJwAVWyagiokuIzQpltyLe03h3Tspoxaj6i2YTvv9QYeDMnbnblCTTVjvpcTTp5d5YpyRdoymW58UO7D2yczGiOwg2qxr0MvQ066W0CY26bqigt2w8GfRR2qgfB0zlBPurhGnJVZHJCaMA9OlfARwcDIyzyfnkKEsTwv567bA57GS1T8EyLjAkQfW5dogTsLD6bsjcG7NNljKNu5zgp9mRni28kQ6vOG3B4NIPJYj3zv1HV5upAP77x9ZWy4D5EAAnmqmQ9uZRqeO8EXUL6vVL6DF3qExljAdBmDbXejPyqOwq7n7DRriALuJL3HesuApNc3Y1nNXL0J4ubtcnqU0aQkpZ9AC3Rf3flMGBvLnCglZv0X4rLZqPLaufDQapwhWCG3JH019r3XurMWHdp6UK6iSE5GMKH40MfFhvUYVRDVbvh6pEajnbazadusKanqLjPsiFu3Kvt51O6h3j13DjRC8M0l0gBpcYDSWbt8NICLpQv81UFqePj9TNGLOji5OaRMWWqKolVjgXJ9QqyWyyDePgF1ba7pju5ak3HvB5pXn3tsSGaGz39nc8dtzqrc5O37dpsRRocUIr9b52huFvujox21edE9U3AiQFTbSCwi9glZh8A6PXCXDDeAPqEAISlZw0wT8KaoqZnw4qODNQfaKhi7k3Y5kplRd16szh1xbiaAPKHiWbb3OOr9EN384LIrhWf3bS84NPC16h7IAFXrOfbDlF2rBVuddqKzq2K6eEfxNVQAWrrxVvWdYo3JlMKopHtKvGwyXY92UupgB9UeoT40563UfPSN3BRUQepEpy7FktdAKmhKenw1J8cdEvtcFtGNRU4Napg6akdpWB1fYkobJ3H2MtNbSTgRtscy7bRbMTDc6BaUR3XxW6pKTOQDIreGxT9iOAEg6fgA2EDUHh2FVjiwdHrvfKaXmOwuLt1Ksb8ggs0O7BeBT0Ayry4LySZhDJ8qoRB3vOUwaiZcUnjhINBOdEM48oeBGi0H4oZAb2hGW0u2QNEzZSFnticr9u0SWz9S9OtWHd7yQae6KEnnU05nFmY8dwzo0FuDtK1oZzIo66M5DhwyT8xmZK55IKjAzKAvB7Sx6QZf4fMvsy9BSKS6tEeIiyXKpHU3LiWK2xUH5RsUdDILhBaqwiKeIjoJWbQiC5dOaggxC2psD0uCx9DFWBbGsi58SmW7OBCnWO8qUsH4AMZNzWFixbQHuXTxK7anDvddEeB8m5rmRTB0SY7V4FkenDu94yg64xUisIG50Tw3234fW8SmWf683D70DkQQmsiAy8VPm12CSV1CMtUDelIGc0JBLY6BjoIae0J2aZgggcEi7bMjnhQnB057T5CprtlxsUlAcyvngBgx18bydxgbQPdwiFjCOL70giDQONglTihoHwGQX728lBl15oVrgKHca0laSShGCXIWpQWfnC7Eavqd86n3ArVObID4NDkJmb1ntYvVm4jft4aVkwI00RRGN7StdREFbfkA4Bz66pVYxr02my4GxvvjE3aT7VLaEeHCRQqdMx406leybRJuBDAGFsWqrMrCPiWIw5hCT5q5e6KFOvoxHTtebMVk3abLBz0fyFHyCTzjNuDohd9gC2czMZ168xDpedNmAA4glofJL2qDPGaLs6WJsAU34OLefjOdrcaH7WzHY8QSAcZDrjeaDgrFOArItkcg7r7JgUyuRik9JzBqbZepOVQAOkXXpyOnrUV0bzSG3vI3lWliBggGiFRGq3sfnNjUVCjd9klnBa5j1mLPeB3AsLV7YkpzwaEXoiZbdCSry9Z3p4xWRuFRMtCtRkrUAR8C1ZPDW2YweAGIO5XM6JS3uP9SBWQTOCBf2PkhKc49PCDTWRb9195TiFPSHwrawLpMGahVBuan3DFFFZ1mPRSkltan7hd2ehmClFikMGUnyGkWqSqiPkh9snIKgrIGVWZ1P0fnWWw97xVl09yqwXvCUuJTVNG9tNEHSCJKH6u6KWrq8K1De4Eo0xdVjkGtuWeIw0sUcKBTALUchbu7FAFZAjtYYV6oydcqqgUOjcWRukBxDAAvSBCEymQYvLLdHGrDbTRGNc0QwVyQHQssH8YcotieJppiolG21HY1K41MQK4Zxu2Wu8JGLftu1ZbtD7UsnNCZJ9l6Ly25zOcYDxnWz5lZVNSdJYqF9hyKrgPQIpDD0lZtgvuNZYYTOY0XDvqluYm9ZB3TYJuLqAaEtdhupjGYQgk7QwHCWBshijuE235kDgGrIgiAKOUqVYveu40YhzojhLho45F0pyYNOrmrtpYRco23nRz2BhMoFUjm3QgNZ75FyOTKwzHURJUt0qMkbaevgEPmeAyoE85wdykPsj86cEygBjfv8cMhod1wIqHTTGMmX42gMi5jprmNW9U5eMXk7jDqdwU5HKdVp6Ga1ZPq7lg1tIJ8d69AIyX1l6LVr8uiETgCMY08mG5uJPOomYC2FZtp4JpX5Y9yUxXJD7yw7FAjI2R7wg2LWPBnX7pN2ZI9wcnCaTWHBr8RWv1YIPQbRtou5d2K5FFCD2FxQ3pk5H9vIe3XYGhgrM5rTKvRjFS33iCfhuM4j5XDhjvKWBrwd3zpGYEhEX9DsdHCJQqlScPUtgrM9AW3Qul2lHi5wSJgmDFATzl589W9NNqKyvKrNvEQwxUtLlnYtorKQQCderqeS8CjC97REJjOGrsg6QBqN8HheYTCLjhz1kCPrBvehbPfMwikbKBPWL20Xr9PyFKu3Wu5w4D8HEoCEFELLkCAEBPPMDyRKtLQhovdGU958mAaiGfizhDRX3yPpZSYhmXkE0rv6WAOGGRW8Pe0lPaHFy7Z1IUbkDPQiv4KonUhfuwpOYcQFmZcHmQVryAyiPgfkSKRSDolFW5UjWS2Iro5mHj9zqz8aTPv8VcBYMFoMBkOol48wYuGxXfPdQSm9ot5guoTam2keqIRD2Z78WEpyR4LXSvZjkX5Tu79hl30mQHSrgDrNgZBYdP1z8eWC8KCNoUNPLwXzNtoXFHX9yF6JgQQ3zXe6xL8doUoiP5RAScHm2zg2FP62tlQsqiP2gyDsOKbt3Q4hxjOU6XOet0tvlwkXL36H45TnB6OPbRl3dJD7JORvUt9beizMGrMzvRzoGQVrtaLIrgIIxks6go8N2vMqRjnbN0mBtnjANVb0pWJiHMWfLtKWtI5eRGC3j6XLtKNPZC9Yn5OcJupW0IkIzhqiWddiiXdoYA5ZRYR9ngfFMRhqFIpn41vMV00tWzJlXBeFiY3Wo9OTWnBCbS8q2D50GznRDDOlMVCoz1qsNpBzsWffq7MVcrZ3ae3QMMdHh8FIYNnJWTqd1P9qpNlJ9Et0JTCkw7EASsTbEaVusIRhO6a6242atkETkue2NDiaejELxWw7jclVPlonWrbd15XAdn7X5RhD8U7L6ux50rPpWtSmeYYFDkypoSO9Rv6vNmqg6TsUfl1Z5Fjt3ue4UQRHtI8W4K2jv13aroPOI2A6N5tyNuRr2O7hcdNzw7wLX39KsJs13mPef4bqlKr5ZPda746lj0bKK5cr0D0pApv6fCrrrBS68X9C9Gh4czrOtrEYCWfyNsBor002HRkw0EJEKcm3eOscta5Hnlh7fHDPFKubn287ZC63YogLiM6SNMCemn2UtFkGp7KzzHnlJS3Zy9aIWVGzwupY66rZIi5l7OCbqoG2vKGvbFfTMQAFwFyt6caZLi9a3RTykDafAEIbrmXl6LANDSw5SIQREkauAy7PwULANVYfsfD9so47QGLDLNm7AIYpNOeyOnr1bqzxZ27orGRnkB2dig8BYxw3MZaDOk2QoWlbhBvsDA73qyK13H7QdJDykyppFOzinxfRakxmFyRt12UdInDk4T37jQGTdTkuUEdLBUnNAzFOkoRmEdYARRegUOqEc7MVKFq9BhsvK9ldOo74xcyinuM1DoJ6eY1NhsH648rQ0wryNAvJLVMp4ZtwGGRyV6Zt55noB98i0ioGnpYZXLZqNRGKpB8lZNgmFSQRsRdaBiA2Ga3et0fki5LI5w3d5doksleR1a9fyn1Ybr4xM8ilv8UuuyZIsbHRen4D6tb2PNJe8GhKgcwSBjEGgFvzzLClgZbUV0MdFew6upNYngitiuJFB4OESid1TJWeJYCdBUTxiLTGMbAA2BEkQW04PWOIfqnohSaqXkT0KXCVFubFI9X1aR1wV2iZbxDXmIrZvx7NoMwk8tCkPjXd7fc2qVLjV5zYwcftxc6Iq6flIKSM8rAzTa78FdRgmS3JVGigpMuyo1RmNIFE376uDmhSTxiy0VQ03lit5DRwvzUKE33wMx3v5gdl637YTiwuG7t6rBaMz4k1QjoW91vEheBibL9nxRfypLgqDOq5lupiF8I0wku9XOzYX9ANSHvz6Vb4NcBoFucRpe2BjfxHzFpQjfMUp8DAJObVKu3h528AotzKrECypyUYD54V5H9MWO2IzAdbP7AuCSaTMBKy0C7lgX9g6MtEYBSjzk54GNsr2Sg4PdbcwXBKk96JJNJpKVNgWClB17XLw9MlVnGmHuhE3rwY3LE9OkchHO25XaYQFD52WfzvayCJYt1J6XBzQ2jKOZ9pihmHnTvKSPk6BuMzlOLQ9Cwdf6KDvqtXC3TUXFqD3xfO8VAhmR7A8WgpWoONBStxdv0JinTT4bOw5XykQjgLywcRKyrxISdPRloX6YmeDB1R4Me0CJ3uPym7riLoQY0Q6Wxu7TWBsbzAh3imygzF4RFpAGRAmIJmCqQMn9AIVqus5DWRx2C571colINBNeYQxslxWOnHnGpBByz06fzyFd35IwthMAwSFK8iX9cHAXD6A8cB7lg5saeZN7f2ZXEFARbTD51b4BYVoS2IsAI3MbqwPWVSIjYUqC1QJuduJ1SCRMkAXJCJfvcBW82nZgouHeCHU556g9IawwkNDiQ4LW3MPqUPyhL2fi9NFOkCm613xb1e8yE7xZAUNDl9hjfOv2tTqge2rjeXOcZN94wKma5ojouQjqnjbjsEVCYIedztP0BfNvSw1JaXunzwHBvxxhgdencpnrF3Cl9T6kJtoJEKHtONZySLgRkiGrKVv1JorH8izbTmB4J2MK2YyenoTGcOfcmSw6M1eao9ZAVwuCu7RvS4L0ZNESZpJCnGAY5qVy97GUOZLIV2bWggXKva8GFzh0kCGui2fIQeYaxv8cg3hMR2B5E9hc4Ar3u4bpGnaeboWiWGQuDFhKYkUCWsf9kPxLDUKnTD67ZEL26otciqCsGihZKJ9nEdY4qcOhNp4lyAVRY82Q2d5ZsnypGoJUpM33AH2VkHEl38pA40RIhKeavt4YmlVxJ8GlCLaZGnin6mD5FQ7guZ7S1EZ5qHrGmq2p989z69JlyjXmpthISHLPWOD3b6FazixLMvQ6IeB10gL3NgiVZMVTTQoW6489hgIoOxyScW2ScfoeWtCCRGF4PhA4IhiDQAUKLtyoyibcBs4gOKrrdQF7Cz2VqOR50ANConQcaxz6SXXooSmRt2CuB8t4pxsjK7LXvGHXvB9m4O4yofNre5M0lMdmtCuHsOGmfXysSmHUTa4yy3QDKH1xPLOM1PrvSsTsIcPQSA9Hz7m19IqaTVZ3pohKjPPmE8wwQhvHpZnDl8fc51zkOjvOpi8TUwPMszArmFg7aJuKF6RI0gDKbraRtt3pkaPx6zOgca2XRK1yBZGb2GqxTUPv4EgRMjJpJoEQxjmkEkkSwOkf3cYlXWJBQUXaLbXz9cc7SVjI2TEQt0surey8gC7K5gz1Bz7V9hv7ph0B9FPXH0i299tqcQKGzX1aErhq3ZipBJbFxr7QMFbeneioAkuO3OPcS9dyPXQvFU2WZJhbtYxpwhtdv0oGmI61Dx87qnlDchQz8dshzpyp7FOhCExnWlXrkyWi4jQQgJa5cOhdFLwHKfZM3qGZUnqSYS4sBpjNYDEVs7ctaUc0rbb1bA4lfrLiyDrJukDGUS69T1a9smcYFg3VGuIs1W1cztY3nkHKoPOLBq519gd6PpucGMMsI5tDvdp3WYdszimpKYB6aLCpFjCPoMWJ9ByGknIi0KMpVnftz01MfTyug12G1tlm2jDPIAf8Z5IbPF5x6vZOt4lM4IT2qbeYe6u8EG1DSVXv5hSmDTDl1SGmqHAaqr33gTiAfdZwEcFvSB48sfeiUzHh0p1OMVTEMSNmf93znBbVtbDF75cmtQPBnFjR9e7zoMjGBl6VikxJqgyMMh17q62uYzn2oqaUxMhditCpKiWiSZXuw8jlGsWcUHbWhUy4gXpcRrmbh36lS6lDhbzFbIB1SopdskkC2aFwOgPPaeJ3qFa5JSNP8Gj9hewYYXTN7dLw3dD7EGhX78SX2D2DyQrqH73mewA1VUPwIbB0HvxuoLnlhZLwpeGi4densZO5RdaSa5DGcPPDiWG9vpDnINZjipHiTm4WpteNAV3E2RCsW8wGXxGfFhLFZUc11WWOtTEINwkG9DV1HxPptxtrLegSQaGVpzKxXDpE3Njki0FzYw9nMRLnoEANO0YXXWoOXVSetflh3ygFnYfzrBT8kYxbvNIyrZWt7SxVufTEiFMApkecJNvAEsq7nvmYjEqAo67LKqSPsj6sn0xJB9lGa28R2Pdo4cZRvLLXvc5zf0RixL6dZLCI44B7VBbhEc6H57ysMfeZpESiDLFIDcnW0hRZVNvfQQTKPse46hOeP9PBQwY27EZ22nxmHobScCMgHdmR2ha813crkSOKZJPraMRXVxAjfzKoZJ7o4jav9XAgelLGePGDpuimuL2o1Hu7yTpMpnjeFYjyE3NpRhREgdQL9N8vGWQaCKCT6mLEV6XjwGQiaTEgBD2Hlz0vBGRYJmNNCEdpoStgH4ahbI0uVR6x4BQXfj9zvbwtoHJD1RTCCRnLOctYcpVUU0UxUE1yDibk9g3c40icp04vi3Kd9slbxXuYIVPxLXHpW0t9lfl4ejJSEoB3f0fSzOXTZD3iuoWL8cNq6FRakIqbtibtMvz9JXju46Rd93w3DivUYl6bI7VuzcQp6T2LBCWk27Ic6tGzZNZRbBK2Xkz8kGn26h583qZRGvDJR98GYmkdnqekvghrcPwOHukvrw653avjLA5OXq8YraXh1TXqCc9HOsAN3DAhJ0JBQgkg72Us02uJuDy2SvxHcxgpyQFmj6MeIW4o2s5Imgd0Xjh93IwR7PvrPcYk1TKQwKhbx5ZN0RMdTxslcUnoHZv8vCyrntdo1A68007tZBRlPxNEDOGRwykEip6eeAS8eEH23LTlOMxEhe5caXOHybeQZ7CDpHwkHcX2054ncLjfsIjB3xWsyMluXCa105Dbr88vhGy6QQ2fLPrv3JZHbMJyav6PH7QtH5q2Emi4U421tRYMgUV290r8QshDWK9Uf90I9jMjLNl9DfCIukGfq2dyDdu8HBy7r1RM3MmkcrXN6reyYEBxwcWeRx8QrovrXaBfHvnjdWSISeEkbWZYKWtyoCvMZ88UKBSuH44ER5UmjBRARGvFARoD9RgXUffZPpW0QLZyvLajl6NU0MInvXFIan3f1iJlsCkYLupPCRy6W7YDaH4WxXkjnblthK0NLsVISK6N7sXoo3Vah5rE6FcHHsx9FiohhQGROcjqLRzmFyDIiiJNzjkElTYrKkca1sSKf8zoWDUklqlYsFn318brsUvvDlrLobGdRsNiPFzZfmbsUyRcmlvoBN7poQgfOkUFtMmhA906X81f3hQmbb5FPSEXi7w5HK24EskcXN14y31X70nnecfkrNUDZfTUW1ohd2U6Mso6N5staBp0z9r1JTwYPuNnWBoqnNN3hLdQyFt1i6rsJKZQnudRmh5RxcP0sE8W1K33h7CQHXAtM8GUxwoA3y3bF5MVNiGlLubeBPRaCjw9nPlvJht4CCQK0eU1qs5KYQyOjfPzL0bbE59Lz2ob1t6ts0kR7KbQ26mJoH3rMEsvkXLKRUZJBNn3qluKLojThH6DPOLHAumMSUW9nDXrA6klmCRA4IGvJQI2rLi8TvoU3p3fJxh70BuzkcqhHJJiDxs8LiHx17nd2PTGCEnzsNCCeJvVfrwDLnrFZvhiw0WNa843CD7sGdndVDjpL85szYAbS0a657mYYXnb76jnNId4LeJA0Q491sx9w16s35eOO7cFOt1hmIGhzdyQLMBhhvns0TRWKxJFA31NUgXIApA4bD9mgdYtV9UJuiW6TBND4yDlnaY3dZ47TRfOjcRyQHEB5PXZ3rkiijUu6O0p7WdDFlgfupaaOqwT3rz17pR3GrB4ugET4axPEwikKO8cIijcFrZv1KvsZjCxmvC7rMhViqR9hgcY04XucLDWwtWSnHLHqfgzAlB6exZ3kUouxLmGmEisTTftJcl9efByOnDAtw656YJkstIn0gTSOSnI6jdw5FcD7BNQpr4e5CtyajrxwnCCCNVuXaZ69Q0qQB1qPd5PetsrHacziBIljqf0jyCYgEKQjwLYRn6wFoQ8dyyZ2uq0R3HkSfB3vQKXXoSyjXYBv0YJXHTLVfLAB891BYnC0UQjyENGhZSxi1usm3O85zvvT7enhfLOFrbla5UdBu13aQglMXJf63ZmFmVmcfXnRmlwesNWNoWemZXl14So1X1ZBFQRMI74Z8L4et1t21AEw0NxoxCCephSXN7m6tD4yYtzOlFTGjwT6ShIfvFISQ9ulXF338zbd4PPn7WiBhmEhOccB4i7a5hw0cV4SoopAkWxJ4unodmLZZrcw1s8THKAf4D4WDsCyyjtztQjvk4oC36Ljv79qorqfvUIzjhQd8pUsfqK1kRr0RjZOVTagTb0Z2HhVBRlp54sCtf9LJGrfv8lT0OwrfDFLsstnM4puAlM6CxG5vNkiyvWvEE8uzGsY46Ifm6OID9dDvzH1DIdRWdiiZugQsVuj0hLLXzttLsklHzhpn3saqcR4TWtwQb3ekKP9hDLhwxudvlVKxls8HoKbBnf3onrX1MoP31InmF1pYhduJMcqRcUHCA8TVjfSd3TIkuMKRWLZzAPM2jyp4TAe3R1GRYVG3qXYtgQtPahX1UkUChsY4Mgaiqg1UyDGMmcuQSSAs2AkycDXZHJROQbhfeczsMr9UzF75UOQQAaWsnydh1gnCdSIrQO5k3OR4M2jCe25Yrttrc18AArbQKgd02lvYTdylFkUpVze2Y5I7xU2dSDeaTVx94i5SVchew21XniPjbM0Pske5k9k3gVFguOrQ6eb8fcPqWkv1GszBwNcvtJDyv1z9e49LzEvbcPqK6wymwOnIshXZolJ4oyq0uGK1LqqBk13ZgaL5RUP2XwUaxb7UoqbPgcoXj2gVDADx2Uxhxs2yE7SYN2I4KOTcHNTtw740hkH2EWAAWkhmM1BH0wklsLDsorTXHnbOaX6hbs3zHRgVd4qxQnpk8yU2kKaB1iv7LDWavj5T8upC1TeszR60ijqkKfUSUmsIn52JnAzO2RZzc0LGrOCvEjHXm4SpLD9WTQ7D0Q6l7kScfAGGDmj2LI5uT4PeFf8DmCXgOAnaJGexD8v3Ol3tpRPnyINZqTpIIBpoMZjroJAPkcgfW3iFfTIUoqiOt7Oeldt2odsgJjdwezX9kZAQLvMiaMd7t15AQeYcpUjcDyudeQfJZFpYeByZHEzX2yW3pA8iSgmsBsFIg7uDAbugmNYlGI5qXx17MUU5kcByi2Y2HzCxKTCwsOtQsc4jIURmyeM4IqdaLRoE8u6EqYEkN2XTOBlzJnQAjhkylz3Yyn8rtq7HWMVs1ae7RrIBwlBzlyJyMEdG4Fmu4W62e4QYhLE4J2W7bWFyD4Lu9tSjUQ7rk12suQjdeXMs9rA1DszClGD4qhKOQGrB50dWhmTxxIBbjsP9cE5opCaqsQIxvVybSnrnQ3g1CYqpMplaoUMbp4Iy6EzbqiutX0V32xm3WBAdnHNQeL5z4ZlE3lZ8xobv99RBRP7m0tCUeffkyJr3uR5WuIMbPNsojaIJ7rcUH5AwA5vZoT3tyEGltOuPM3RCxEgMIkTjxvJ9eYBahG26HoFXigTe1AEHxaGKqYzBwifNjebSkcNW7afvUa5hQ8NO9XbfPznsLclabgP4k2QakRsV3mNim7Ib7tP4ogsERAgDEtO4mTBTW9MLeTHsBKL357Wnd3ruOPIXsqU6VvixfOhyFiZxKFqnSCZUbMnwVmKjr9EIkezuKbU03eqz3QrfQMRoO9oROCBipne4gJLHz41aJ8DrRnNr7P4NZbXXhooRS1b3Mv748jCr7ScEtXuOngKumS4fssmqPcP4bO4oR5pGIwsJO7UnvQFaRLAPcOElNzzlyBFsogApbhemZIjAxPGLXqv3iFGxr3MP1PlOhPqrsy6xOnXvxC9uuetO4tu9l4BLqdndyedbCA1ZYknRJtRgjGOub98NqriBoUjC1oM8SWlfWHmCxsLmaxWTQEhgJLNAE3H7dzj17sDEO37ZMLNB30Ah7gru6uEBPsNhcEdfzd2UvX0mRFttjgdj1PWX4afPNTxBkXh7KNjppKhGr2wpq5j8z4gkxxblxTsZceovccRCINYoIkZMP0FHPdL67aK6wEFjisKAZVGYuZG970CYaBTvt8RjwNzhXSn1brhMRKdjYsQZ0Mpu6VxrYkSHIuCwYacmKjHKOlJln05OHfEeztikD22vih0m45oDLRvP2DUlnu38aCbjiKrWPBakSR61h6th2TjdRUpcVbAUtHP9z5fPeg6uEqIWvGCbyxLnbrzlvjnKv8B69GTHooHvP4Q3hae1R3mJ87tB24IBf1nLv5Tamm3OfIkeTTQ2EWlWoyc6GSVeVje62Oz20kHHcq7iFU95hmM3LHNqKp1DyNZpUnKyhW60TJcJxEG4lB7wTw4id6IctLQp7twHtdZrfkSqYuguruNIKthbUwHbxtCuiVMY9erzW5ty1S2rJA2EbGIU2udxWV8tZsOQFL3SuRs73CPYddoLTiD03r2WCC3bWzbkJD0VgXMQNipKu2JVaZymYc7OJLgmDKtAlSUP2zDBwjzLpiD1lvnptzh89FYQBaw0cWcN6Y8x8krc4dFodagACtgGlaMVLj0YTYSPNq8E4HSBVZuXdBoNKaig3MOas3BFKh2gMSSK49WeEH47JQHWCZtGa8pzTI4xSxo6go60VL3bOGSqNfk5ocvVwwmWDX7NxfWPdPRfo7VW3nEL8WYqJKoBEH4MUlYikbeKjFpyZxJFsrWX2Xk99x0XnH2hKZgzopS8KUd7c4g2FOL4Zxm5dMO7FTC1rcWIj1FajRhJigZcSrugEQpijLck39lMQldVe6SMq2jsP2qW6H8qkd05b8Nc6eJncE7gAg8GCtcyGB1JuKbdRq6W8jWeBQ4yr1Hpqv682QpljzWFaL1lmyqcvEM5VfMgnoSVdzNgdC5vXPR0M77E6EBHgWfgeT57MDU4gWc0VhwyUmiV7aB8TA1edaRS9ljHtValWDTjIZXVcusZnDpewdJ9wSu79BaH0SXN8VTIIowwtrAefeW4SQSYwj3FXWJIl0tmj5ZefIrL9vgBhPIhSh5lg2rjQfuyJSPAmuhvxrNho3ufsiGYefh39wZjQtxD8rSXa91o2OujNLZRSFmi9kTfkAJElbvIQZzwZkfgjGnXzpxTXw1QJn0kpTTUdMJBmXJCalmtXZmg8zi2dAZ2kEO3So57ZdhBCCz03YGr0zZs2w7TnDeMmEZ0tMllnYc57m3iO4kXMNNgQLBtxwEmOvpnPPe6yTNV2Otw728FOByflvqKPXLId5GJeIQHohIWoUhRDb62nlhqN6Ad8gngodK33MZFBtWnUj1fMPSUd6CDEeY4MncoqDeDEyg2YhiIvcLjwSd8E3BpzGtIkw9vYmWMXszpYxkzqEhTnYMmnGNxtQIHdEzwekukhSvIkPpdeKaurrwtk2Nw5ZwOJdVLFcuBLNHU8tdQDYpk1cMcgcNtWnROMHOaplU38FsBlslTsRvbnOTi3qNWHN65bPCNGoAJMF9hQcGncIaOif5YhuElkKJGgljDhYpljKX2ciWavmY5puwWwqRd5yY2XC1pL44OqUaKMWmKtjUHzVLcLgzX8gWVT9h26Tyb9PixhAfQpxYsNJ3Oy1X8K2DzBk20W0ypAcMLOfZsQIx2E1vpKw1dlRM1BEXWJUGO0UpS9qB6hY6Bn1Sv68c9TUvA6HO3pO4G7JBsIZIbajuBZJXIv31DIKIj3i8ImAq8PR56lx0Y6c6nwUGud0F22eatRRZG5cIQHIStHs231VKoJDDxJSa9EXpphqY0JCKfFCVkhl1oWmXQrlrkrhomOjaXRXG8rkp5VjdacZd4kBYMm6so7R0Mgomw8Bk1v8hhIIHWOV3IA1QlWwoKNZEIFaQ1V1Guz6JstQ6bO0VWFEkUONaPQml0x2atz3oRVzSMdyjDvPOiioJjFJBBPhf0XVM4XAE5HBjQ8d5QVGTZs3MSvKIN1qlVgfzERU1Qa3ZsIYE7BLGsAystVcrS9RlC7VxtHTKBlncirYy3Uieawd9XTEwe47TBTm0gs5YavMlhgyAwKt1BBv02ei5LlVbVC2dcvqmQ9d72WGBf6LkRG9o0MxqHfj9ZNinwEFvYdWwa3lEh7zabwY0P7j5GgQbgYxmI97wd7hqj2ULAWEV2pPqceNvFaf5SPbWjIxgwQO0nZMfIfey5LX7mfkJLHbCGw7qJoksrk9hlDBC9NwKQNNY9qYjO4zuMpqvpCB4JZFbhKgyGus1GfpHkO1RXllKrz9767scfk6lHhnU03UTluy6r7DcQCNCP954oeKLR0PQ0vnOYEtnQrr4PzECI2XV8AFf2yqOYO0Cx0pBw23WBq28UkBGhMJ2yzhmVu74PSZhoCBEMBypa7ZDN5YII6V9Jpnheu3hTC1ROTO615KmIG6JW6D3nvvsB2T50MThXyJZeX1LI1H3zOPZnreyYiVkGqhHTwPqSYP5smRcPJIXDfBHGN3LAtPfCKuRLlPxnyFNQsKR8PFcfJeQfBJmc3PlVbbEDxIzTPKu8c5L1t6GyssHHCYxhHxSPrNC9EBAJLdLYzk56jLzCJeg7Q9mA3TDToeoqrlKz4CRnA8ZBkFgKbO0bmwbaghXAURLBsp2G2j3U0Fo1YRNTzAgS7CubrvemQZROkqT4yRnEGqb94HyJk8XArKVr1MKaV8t50xIyVNQiPwTbzBpu0cECLff17OCwYR2TPEwwKwKYqnucAcYKgFWd7X2pmuPDieUAIBmjl2T1HU73mqhrCpp1xJjaXW2MAEr39StUw767AFsuYlQGWnxjIiCpQEGGy7L9E3RbSS6daLTdMtjs5vk8VCvynuVKV2grmlbmgM2KzLk3ujRzGYdKtiAPNHLQNC7fiHoGwmuD2ECbjhZRsXDmxqspRxszyTMSg0jT75GhkwzEQ7kVf2lbsehUunue36tf3Ib0BqM8AwqPBZ8i4Paozb2snFp6imMoHCQ0kQCX8J2rMAZWtsBJ34S9iy0L1vY7c9KIpVzOw3FJGhVuoH63l9XWfnsduOD1V66GFJHze1suikeDO6C1mglracjqWXZnETvO5dzQBxM3Fbx2ZYrHqN25d9mwVXTlI4l5cpB9vk1Owhf9IElugMzgUdPmfDB0tF6qw05nmlUPKFgZKQ9ppP3B1HzT0LadZZhhjxvDY4d0qDrt256HNBT0pJN4YMlXEXkIpXldRSWuBnhz7HEbz8x7F6c0yXTGdurabHeAjSR86A7cXLY99LZmtNja123w6Cz8Nj34afWXLxVilWeC38ai9Smp4QyiuLL3cozfwdMNljWQpnAUSH8ZidTFlQbCxOPKWtVEyUIiaMOAyOg3i46ytbePOUQs0GriDrBWkObT7k2aezI2jC8KzWFmyy1xOV2rAKvIjoHfnpxeMJcizUB0QOjbeGXKkLUF9gitG3aqnVW9qPHpADJZXhHakVYNW1ULfzwPDZ4mWiYFo2xNqd4P6Ob3xvTCJYFUSLpfeDnerJW9uZlWikkjCKBck7ZWPHOae6TaxKuoy03O4NdZxAAFCkbkc2fGmAS3qVJ1wbT45OfYWn5Sw8yQG52qxXKvIryvAtn2hswyxSHsAIgcoPinM6jzARSsJlAzLbOzoFyH3iIT0WniKhOtVYevgUsYvjgItkuICKMfSoIzbOHTTnDQOxGS5lyHitH8GG8ycb4GIv1Ck0qzhtApgAerJsyHCzRcFqKx38JJuj5kkbAIv5Frxs5XUAVR74GnhQVVSxiynK03K4WHTdvRr4pODSmDRIIO7PKsPHcp4YMisYt9yN5nvXm8QduyPZTsVtpuGn3P2OTRLO7N1QsBefjGqzfmMh7PMQ8aAQHxnm6qS1p21BmPN43HcHWSAkRRTRcHExzD0lOEjo4rNBvgGU9WGQgct5Jrd1TtETpZEl7KTlI7oFaUwiRd3Lb6wRVGANIViu7PGtpIL7yz9g3lFOKAClQI5s60lbYxqBs4prNyBvlsUGoOFLxDC7loGFkmBuLrQVUfCXfutHnwtFPLYhQjQmCNPiunR62h3IucaPOinAtfcJUMJ0bZhtxFua3WEVYrAB5EaLZiPBi0AogMRwcVBmvs9a003TUPPfvxhJA5t3X1FO1rE55dU8yCXRN0u8Vmr74HKSIa4MWuiP6HDmcmiPjPGKOlJJlomtuqsbHb8mhcD3DJrMDmhfebIKxNkvlf3OpB13itQHrfblf6ECouE9RkTYGfZZk5d2BavLrD00QKRosTCmM3yyhUms70TgU0THVBi4QPSQEyiY4nw8T43re7lUgmiUY5Uko5x8ciaHAuOj23tdwbTH0eWSb5mGgOrHiwCh7JDbTvb3vm7MbO2EQDDNNfRSNbROjEAsx2K96rzd3StvpMCy4EQILSxYOptCdO10hR5d1kEg9KGIyHneCr6gTnkxhaAMw4NFLaupBrF2oLymqTTEfU3Eo8T9jgL1tbUiG5yRlFZoUHAa9no1YVDH0XAOwK81JvnrgXK55yb8koELAq5aY28rHMVf8nvrMy5Bg45yCC0U9VpJhfqKpmWFrgHPMtckYFoz9KYf8dG7e52y8kFe6XuHzyMZT1LzgZDDQgneQJddTgAedR4TdatCQbdozjpjDZldSxwBUT0gzvVa96BK1TZUTz4LXhbdHpdNcrWwUQ326XxI4TqxLn23JHzx4L8FdjYvLSot1c5wm1vl2WSw4AIqnvrnzYAyKCZx7K2S2Fad35HJYDhyiVJS39eSlSYlvMD4VvGAHhEkEyca6MAr2mLnMPaidJIbXXXQnnOkgQhPmVObMkipPnlisNSUT6Ygdmz5PxtKsPxS5Kb6g0LrKp5eyRU8yD9HLSnLOSGmsvCG2lH6xOAkK4twP3eeYez4RbpjiorWWIi0zM6SilkCtlMKwyFf8KgbwWWOav5x1WZyMwFvw2GAGnj3dsrROHb2S2ygGTKTztF7hbhJjPhWwIAxwia9eywO1Dql3IOBfb1CIuaXCLrFlWytglREYKAVSvd2tAkYSaTDSIbomlv0fdfy2K0HhJXqXEaWpahFRvUAuMFUqR2HkybSVur77rez56glUe3DTpZ7moY8kE7QDFncbZcKgaygzabRSqKS4G0IYy5M4q4LXq6KjCKIsJN8t8jfTvT9nznOPBvdsMPIk5mJTc8Mvma1wSZjQeYFLnTvjW683XJJMMMxd21hFjkEw1DF4w3g3pmhjpcZ5xX7WYFhyhhoqyjVmhu3dEwmENX9Idc6tnLWNC1zxjG93dNyy5JtuBuvCXDxE2v7RkJJD0cl5emu0YqaZxyFjBBviE8hFB3hVzTTJFgJgrcX2AaCz3l4UWWG075CHkeqRr7RQ5PBwVSuL0IRjQRM6aiSNbQRtwqAhvTdPbm2qRom6wjPhly9g7DN2OGxB7FTdeFkpl7uTQWl51tlIAJwUkKCYfuUeJ1TfKARQLWghaRToshEQFsn3bzlmGS6ZF4AMgYHiuNu0RrmEdNoFGrgNh6i8qS77GlUipNGJwTyRc3w1JTtDEPJQT1hCKPeWHY1VNk1t9wyTGvCIkm3M9tRihb9RLnRytWx7ZTUMVDNIAzoAHtVe08L3gLyQ7jOwjMRsMkGs3wu414L2ZGzGzXJcrmWHYaU17BkWBA0hvd08ebXhb9WFlnSa6coMIEhIVHLpIaUN7pX8JUh614wUwgxgEPzwrmbr1x1Okg1gTXqNEp4j7u1vip0Tx2cfqt2uaIpm6ukwMkhnS5UZkZQ6HETj8mibIii8CMr9IuwFuKiRFepMzzkCchoZKx54ZL0ceB7eMdYxWazSML3EtGnJdepENioWOQ9YwHWqAtdLtiiEGw8vw8po5bx5koDGVIsgLKYiCt2RbXYfoJJ3VNpRrrqWC85mbzBJ12hfVzNqUy6OuomCrq0en8rrXLlaQUuwoLX4P965g2FGKB39l8DjfvmmkG7TPg4kiPIirXn16HdA7UdDXoI5SyUOmW3BfCIbWn5Z1vPFhJKkrLCGdvtHnUdhuo1RDtUHwwmFtxxjlJl5u0tRJXjK4qXNR1gNoO3cAd7cLX26JDNSfy1zxxcXljKyDR099GdMELMkCpEx0wQBTlkvdWKtbQFMyKVKJ5XtLlhinN8RdimxicrlgMJL9EnQ0Dp4DwbPpnubGSQwJeJfPaReQVs4g90hqCd9NFDMJLOIBuc3gtZEFdHatTpLqkJgOm6VbjAT2AxtX6mXXipRUyxtmcgfiCQ3PB0MH1UswybJF6f5AoXlnJNJpMm6eFYl59FBjRUe3rir8KJfx09kfekSsQAnivJybE6euNfcf5NtXMNvVkLghpmxHqpqr39Cg1Pi4RNVCrAc1g8p2zk1XNIFhecql48DujlKew2QK7NJxrtWu7VHy9jWQkBxEvI64rDSnlev1GB0rRcAM0iIU09svA3UL7YlnlXqCa8OuxXpd5KWnZI7wzWWLi5wsdeY5iwFxp9fE8SxEEel8lXnd7tmnFc67bydrDs6qcbZsaMxcOUjNi2mbT0Q1dwML33xcjCOrzEjxTzrsYng7Pt0dD8gE73FtMWkRy27H4elfUDhRHmppYbZLEHK3rrgCWVnxw7SUUVV01xNd7p2pO0fhE6SMMBskjZYCECyVhwueJHU8zDeqVqMHarkQUUivKBomR2QfZIuHi9f5tOxz4ELxFLevsZ2PC6JJTjR7WpH5Ut0ZKyYSetrA2yfkLSxgL3HPQBopVxy2osj8LV0XLwcqMbdJDXEAdx8GI43kcmrA31IsKQvwryM5BDuXMsnhL4eAorz7kYdO2b7AcdXQqwJ1WVG6KHsXlRNz4rw0IlfOfLzy79D6bHeb2N7wuxuhCo0xyx5iZsmyxk7gGtqyTfF5H4dEdAymmQwFodCzxk4HyOGCvoe7RAG64ScD9VQmJ8JES8zVUbH83HPESVu1bwD5d3vAjhdVfwwp7ddI90lcuG7URCyTAZY03SRJYEqMZs2FAf5ds9TOgoKjxbtOaA2shMhFZAjOdkhHf3lVb6J9KUFTH1AP4ITMxQjPe4a1Gu0IY5QvQe5FGRm3BfZUrFcAAnfFLB4oenlOcXRJboGf0ZHYYb3gjn52z1x0kW8SSeEeAIZ5pJ7Mei8eEa2oEzFZ317J5eEBqaOkGuRH6ZhZfmJeVFYrDzjBHLxnNmk64gjIGu0nsdJo1f8RxyBZDvc78jTgz71iGTf3VTqNnAdv89qgIfIFsUXhPwCcaisjNvpFrdfHN3MhWirABveRnD0hALC0eu0QEgrlOdLgufuvSHCYX7LYvA3zrxQC3SajT0"
+
 type GatewayConfig struct {
 	gateway  *client.Gateway
 	network  *client.Network
@@ -63,13 +68,14 @@ func worker(
 	counters *TxnCounters,
 	gwConfig *GatewayConfig,
 	txnName string,
+	args func(key string) []string,
 ) {
 	defer wg.Done()
 
 	for carKey := range jobs {
 		fmt.Printf("\n-->Submitting Transaction: %s with ID %s\n", txnName, carKey)
 
-		_, err := gwConfig.contract.SubmitTransaction(txnName, carKey, "HKcYnb5oRnOJoX58zv8ZPER1PHLi6OlEbWxwyxGElCCpkU0ReJXhd9UhB11ByluVIwQawB5yqlLDuI9qvCB98arAcHVip689vfDJcu6uRbtNIVhY7E8xmlCH5gSEvFBfkLLCpDTQafbJba4Z2K55uAWUUaFW67Q9HsrQxYPRogLA9ghW7PCtqiWBIECTRFiVaXYmdG04T95KgpR50kyJOSGU37qbBpdmYdQDdKZnpjpfmyssfqqpJmHT90S9qOEJlgqaZRAHwgNwBzjjWj3Vyu04d39ROPbdiNSKYwcDIlmzUxpkFslP6ruwX055xudATbtvPpyVgLdIlZL6h0h2nyAz7kMspCQc1q6ICWekAausYGYzYhQE9WHK1sJbAr1efIXA7WUX5ykjmWLhpmZ4apHoMyfzc2vRUQweaXX3l0PTaObMFGNSUkhRpQA4UJ0U12GHQPpC2580Wu7HEAUUeTkb8m4SJVEZ5JwianUEzSnTZcvC5kYLhyabPXih13G12sfpeZhq0Pjlki6aPP8oqQdo2eFsVBt6Pfyp28fBA0U0LcjL9hQFUNjKKCTU5oTYjHIJiByY580LqLtCFfO9HJVaLkAm1MJuP9LHr7xn9ZpxZRqR7sz0iluTgSm0pnK0g6dSTOJqporyNmYq0FErFpG1Y0MqElTlMZb0DJ04x4j86A0qqLIJ1dCuuhWAsATm1qaAMA1bODmKWAU1AKL2jzgtVlYrHS8Nrb9guS69acFbH6Po3ixR7EdkfCGOHC3H8xUX1iHizZjouk4aPN8oRSlHXOatNSsiwZUFfeMgx4wrAdI5vpdLzGuj4fDeng9isEKQDCAvY14sLwjNzZ7pJ1avnmlbz3zvjUS3ub3zqE6SqVGMM4uAKDJ6L8g4iCsfTxJdspSOWquTbnNvSqKTfsnqOnl8oClZWRzk4ASSVNRl1NKpFRrKUCVizxg1KCzf5ODdtVmKt8g9fl5KatlaocoH2F2bf4kHGWYQsoTaLdxzGSJNUY5vJ3rZdjMFCbl8VHvlNiRy7ycPscdHQRoTs6j5ERXlUMWOMecVnZtvz3JwvRTZ0SthTw6bfFIsf5GV19wN6sLbfo9GuKY4AdTRRCWzQxFTZkv764ISFAbn2vW9Fs0KhDUCShImK4TnUCFs68RAaCILfPK6DTZPD7SqJ95EHotOHnbCc6u1aeFjN9kVte3gclptuY36YxRXLEp8rzhYeDErPPfsmaDUu2Sd84FvJbIvSAv23ZgZZTvZqqATHFcNlEUdUoklndVTsBqdBzGmAaGUTqnPBiflgNjnJM1oL1p2jkNp0m5gY3zLjqtdCxkj9zUwKnVQ5V76LMFpsQR1ZOVoVzAPCtKFQouTVgRGuZffuIdGkJlHT0pSkCponAulWsLLJdjA912dSLOhYKiZLElBfeZhEqzgQcVA5pn75GeeH2vrCo2giEZyEXuIaVxGswTxlRGqPOAiq0F5b013LD2VKYIidBuOlzlK8PBV0RDjlY6pfewRpv1bKKLhxjowdVNdkYhZOaQYPCBrg1Pb4QTd5y1owp2CTiR4Of3yTzFTKgHWBSJaOpbHxsnthzUzDZyk4bysNS4XVH7JJMRVaWzO43CGk9klnEt7GV25IycwYNKO5ZB570sJfIsRz8Gkr2LLQjigVAnni9HPEmfOcqgUadGd0sE7MYwAbFBu7jGfJqRB2TYfAbYaPtwkKuOe4rySmjCDK3vH6Tizv5Y2NGFFbA683g51IrogP9zCyugJs4pvgVZnXAB4G4xVGSzYehjRozY0hzErCUmUQ3ecwgyMdpCOOaIvWksJ9NnRFAOpDcsxEQAna8XthhoUC1HKSjOfPAwnaAX5iMXMHyexvnps5EayzWk7DXh3Os60puqsZU4ILhrbYhw8EFBQaAcBLnmpFN53CpmR920cUSkrbVtZtrifa37A8bzIUnkxazCsdtvkbSFCunEpoT5bWB31r65FPgQZ3ASdAiEzhH3Vh7vFzS3T0WAJAuH3TFYw9K7xbKkiGqsjv2sB1lJ4u37zW9KtpepyOaMfEKUVntUhCc8oFgyAIriF42sK4u9ktPc36IhggvOTNqASEY2ZYwYCronSSV3SsfNYaUZZg75JG5CROcfDRRQrTyKotYqZ1KNA0mZWsDyobpJXnzfR8lwpBJC7PUJkaCcUZVKBugdZgXNjdRXeALX2jUGueuPztzXWanz4B5mKfW6JYFKLyJXh8ynv9rJni6g34wb6S9JwULiOB2cDgkX6hl8vkRlEXzVTgA2H25qftRtlnMODGllkdisUIxHf0hF1oVnElpE1Blxz8e66nM99jWcUxJYod1CbagxvKcZZwxy4iphctyQ84hHBlPxMZ9Hk2EtB3P12RD5jn0hedoBNM7o6DCnLuT2TnDiig0JYre8Qqrr18AeyXY3ywrNy3cnaUHWNWX4KiSI9nZWvV9sfzxfElgcCd8CjsKD0xgtoYXyJzk25hr1yfuz6dyghvmXuaR2RzpEnpH8imG6uxX5PIVpXzonHt2CyAVZP0cWxBK97Thj1jrepYJbwzf1x6JWfATUwnlBxuAesnpJxgb3aJqNA9JpHBBgJHOdu3j0fnq76uAFMn2iyJ0B7T9UBmwJIG10CYHSLOUcI7p3AbhMha3GSUUL1uVA7KqjSFsvOKb8vHtK6scefzIcFhCFAJdxWptZzXs3o2u6OKagKDpQttMWMuD7qCoA7vWBDuCloAhJAJNhv1AHwpwnLDtVmvQB64WIUkSDLcHXpX0Qj7ag14b3y3lDJW9m3LXqExi6Z96tC7h9RPNKfxmJoNN9rTvp7x6jDdAnsJxL7cJigWlw5NievrbfP3PXsVsFjF5sd1sf13WL8dib7NBCVKtl06bAwNJVQofQaMG6iSlBj6jLZ7k99VzD7YJHCj0W6Tbz3CP3zc8QUPhA0VUpUi7diVdPMu9z9SVGI6HDm4a2Ua9W9Zxcqy6TL7E6GqscZIT41FZYwUj8bNdsYLpSW53U9eHXyrCv4cSciDnFKIPwuhF4bGN4kyIcAzwwrreoMS2QBJxewW9FuocqOo9RhDyo0jHZDS0jUVFwGhRa3vO0Wwk1zWq5BqtMeRBIsByFzNqUq0FdUov9fmT5gSy0eFvJ3vbUCSidWAP8ujaaN002j4KI9LF1Ik3R6apSEQNk4PrJEHnGllK4NH6z1RMfuhFj00MPWbGALFQXQbRb35BEycwgg6fU2zka8Bg3QYGqedb2eu7IuxyyxFILEFe19gkixgOGbyuafDz2zHLdF9b31VYtDOmPOHP3AvpKyHhsb80vfYeYpQBypfzOA5gyEEzx8aAMsGHkoKy9c9mW1sVHt5cVRnnfNvKtTa1Iugy508XvpVLwenbdJ7B0fd8m1phaEy6UdAA4xb9jsvBtqDHnav8p71WNIBsNpxoJriA6JzN7xT8MTeK3wwOsrTVwDHEA2G4XllmzjKTOqIzlgKLGfUQxQftAMLMEpZ79Jp3IuzjRYq5Xb49gKasciyumiH7Vr1dSG2BFvGshT0qPWiJg2Cl2zLirHg0de0gsJJ8Y3hJ0jNeMpa0RnfIwPe2sdPR89cTCiDabLEG0Shl5s8epvlnEfJakqlYJmCTKPqffMT3OhGaHMWyDlt9qczKmeOspOA3Jm0JXKDOWwuqMh5OqFEmr9zkFsACW2xLPWJ14bsHRF5JpVigwHu69m1OiXJlMaNPRp3UTygPIyLt7Hd2sDWSfd15jKMBoBw0HKhkLeU1aAIGsWPrZI6fdp08Bwkrx0rYO9RBWekBHLBjLjPNs3LR4SriuZW5FSD4WQ2oCukPjAE887nXX0dVaqDmzE6t3zlA977Vm54vdtoZDQF6Af7T2U1tJHWquvf5rhsRIZ9MItme04ZFVdBzeCpQVIYliRNKhB6rkACR6YqIs8wWxlAeAdgSrSwu93dEfYn54yNRzcGW2LkDswbVnF3wyXizUvByUQWDUEIV49LHxGnzfx5s9v6PwvP2Onovz9de9YIntXWkEoJKa4Snp5LagzPqWPowMp4xe2GMUtsaglWbxGMAzyNpM0vainxmdzNm3hLuuop8xDyPifVUe9QD4BpxGGY2zeirE21JFEpCald3ZobcR0wbM5ODBtZ3ZeITIFZRPQRgl9Jd1MzHaZaASgUUvamoQe8Lgf0bZITXW8LE0bi1ZAeCDxgKu8YakMlEsaI3MjG614FVe5Om11dx9M9iPBjVoDxuRsaK39SqBgKzGvIVK1NhgEXb8rx6XpQxPXQOgKfht09Bz6fhKYMXEcQLZoWGPaimVXhbqBpwCYqgeSPIi0Wi4Hdn7Mxa97XiRr7AmO9G3uaxQfNbszc8n3lRmh41xLVTLG2I2xwhyOVnk3Dwey2meVhHUoan89nBKlYXKiGpu51sBejIfesT2BR2s9vnkRLum1kkW3DdqnMlPWTCWlsAKRWgKt1jUzuoxlzn31nJB124wTSswKJJvmF5SFQLrptaJQ2mvGvdLC6Ka2LOuLzmRz2Xj6eBOy7XWtwJObR7FdOQGRK3XZoCeykrjrzlUKdB3anGzieA8cfVwGTB9cRJeDj9KvCFdSUyugmYVXfKQ3AhXqabxc6EtSAjoMRRxKvniVXs6fPueSl58WYBtxnDoXOR8cZvpYfPn4nbJutYLSwY2Ho5eTlZ5c2Lv5AFsSecN1pR7yeyb1Ah4bNP0TY7aehQYk9sAsI7G5uCm8DwlG2WDCd5E5AaoApoI79ERVbJxuW7CXcwjJH12YOTLXRnw3VBrKnUubQTfssGNo0G3co2ELVncsZXtJgCATIEdF56p4yOlt1dcgccQXuwlujmfYMEBnO4WRxJFzv1AhUiY1FK3e4cQbNOeZg4glKtMR8KhpFAGaDo5f3bYXsITu30xwInyWXS8w5RLCMj9xeKzD6SR3thQqNosD63RTLFmEShygOWZbiEzX8xiX68fMYv2oV3JXFkfotxSnkZQVkTatzoGMTUznhTOYgc6D4UvEvxI6b3OfVJoBMuoo61pcgjBmC3Hxlu8TtomAahluALjDDsppV96DnIeFVTOSBbdwyfnsJMnGaZQTboFdx1zhBwlkvmWewq2QJxbE8LGyRDFO1e1S4L47EqqEewbKcFGlREpeWJGxPj53c11sq85jWllcS76BNwvBAwD8fj0oabfamNmDIOZl3WPgFtkvgVVUHXQmZCbA2hMSHSg25sojoH3V18vqnxValmduoAdb41TAfzU8BP11vARZirDqEEJrjdH8mxl9NLt3Zlv1gPMU8YyhvwZekGEcJqfwzqpNHcfQyybMl0yHOdLdJchs8NqAgOey3skId2OfLBYcPGEKiRGNkhA2joRfVohtdDurtYDXaaZiYlNziN529m6VbOT7hzfbBVFtXNZxrDDB5AEdRtVWXngu87F2ZdLfD3t5ibGLnRWjvqFQFdMgYmhMl54zpXzq6HwTTGqVoM3JbF5BAfwQA6w0LBjYh5cWUsy5FyBo7fvZiTSQGDNi8Y8aP4xkTlaoIxJbe5oiv1mXeCn5QtiUHpHETLyz7A7GNoqy6eiJpSSW3nf9EL3ArgxdnIChkXwkCpimOkxBAJARiXBXi8LpqWbGyczuhqSzQHhmjdStFtSdHr76CVaR5ea7vvjl3l019C4ZthJAPHr7MrIok5YexLjYjIaOfNAdcIfZkGBFJvDWS3ey6TaNSSLdeRqTSlLI2QH6tv6oOFGPkJgaKxXc4dqQYPS8nX1ioWKEtcCSh3o8uA4by8Qn6RGypC0qWWp8QgpaaVr58Jh8CdOCB3Hfykjem29RIbGckaL2P4P3Katu14rQsIEWc971s3fO4SlYRKgGRCqRXo9IIS2n5BGaSGNZit5IE8b7ZI8J2Q1BmwuYumZ0JyFtQw4ZjyJmeCM37bWSBIB0ZM3ygE7yPqV2Gay2QHzIWNRY87hzAUwrbZzg3Elv8IIrQ7uo9gjmWe5ClyY4LMc5tlMJWtaP1Ngv8cT1mi0lfhNSB9s4qfJH8SP1dB6aP3S02k2FYHYXLLioyBJ1HnTmbFs672V3XWUD4opbZMdDF5Ri9XzOvJWjm2KmNUPcbnBnQoH4s6YgRgAZbY5qsbnfaHFQvhUS7i8fvrsb3uTOyLNvq1VzlzucVAbKnJFB7g5MBrKCpLenm6TCZDfRha4h6Nks4R4wC3Xvg7xggGKXYYxHmXcVIsqKnRtbcSh0r03f9n50Rgc25LSuplikJsfvEe8WfVdPvkW9F4b2wFXfZje7SKMKl4YFcM5wncNaWPVV0ulPgVun2BGCvgaoYhlleays02G7POvEGDHeo1wadDn9JLSZ6zwUtFcRSugc75siNzMQJyOYDRobxzauXApSy5hmpkbL37OXT98xbacMKCbyqIGl8ShuDxqaLpSmXG2UWx7sks0coLOqHZ1FAILw2ZlA9J5IOrqlFcDo3QXuYe0C5jN8JVyv7U13pfUTzcUeUZBnbDIJ9LFnA73YldhZy9d4796s7mgcuPCBjM0a6wlLArWbjFHhhlk3B6BxQE4Nwz8JQjpKmXvMHMTvJooLfcBeEY9uN0lvOxCmUGbBXIZLaR1HtVwSmJRX5oooYq8wAAQydnsULl588L34lMDvyT9rvsX9a8AiCRQkElGqP8ZsrmIOehkGPIf6tq3uT5S8oU9F4fqQxB8tA5BCzADRWlKcJYW8LJENZNN4273AduNQbz0SCFaqdfvjw8c8I6EPv5djk2z6PnNbFzhQ404PaoV9kt28LeyWnVyXVzhofBnvGk1Lnk4DsXbRUj5aD6ma5TB1vrnM3JiE8gpjf5U0aWzmfYbcr6mYDPFRKeVMeHtmXsqXwEhiVEGkNVdRU3QhJZR7A3WZnvedj9KAk0WN3Wn4Ulmgv3vKlTb8CAm7vA49z3UfXbiZ7UYaV9aFjPJpkJQfTYJZS00NhCzxB7uFD3wZVwHwU3m5MOT2kQXYKMsgAqXp9QGeUEYGaF7hxjkL4FyOwpIvjr7QoglQcKvw1Mag08aicFI5U8JMvkE8au0g96gTQJqCemR0Wzq35A2A6lGQCPVwECNc4bRCNJAOdjXCIDD5OduVm0MhEh4JJMuadwDICPWRmN2bNGht5a1Y8xTRFTgpMAhK0q7Rx6MzT7Dks7EVw3MhxcHa8neUUclLmE5s9P5dXlyVC2Ikhh4P59VLEue0vJJBtq4MGdhQwmwduxaQO3sDvKR4Wf9uagXKgO6skSdTnydyUqYaxYvWzKtq8sSFVP3ycUWnusBdCFt7q7QruSrlcHy42RTvqXAI0lclTPHnLIyNs7lboxIhy6SY2hfbj39Zlzul3zfHNEa4iNw2RWneqIvngNh0c6RFaHxPB6KjqksALLkjOcmMpjKBVgGkisQSDiP5NL86auzx1GweGRUTwqPgdL2H2EnpXTl0nUgPfAU9ciLLxDFcUd7YaJLcw5k5zvCIKNTGaFs9o5klvdg3ZJLHkaNrajJy6lELGtM8lnCq2b6PErQ0L3s5WRuSat53p31ZbzA9o9e2THkTV9G259uOYsJno4oqAkp9ladsKPlO3O5uv3a5i7vJfOKW5jQYMXp7ckkY4nWLIw23Su2MA7PW5z9zOqZJHjgOav1sDXcUq60hTvKHhiipBpItQHDbghWy3jWvJCPehKUL3LDZGGO9AxDlH03pibSsVyfQK0HruIaYTvFY86HAPN1LhSCzjFQ0sSpgNY1PlAW2jCbSw7SAsaB2A9CrTVimHPLVDy94brq2QD89kbZb3MvLNlZ00NYH8iA4jPKJ1jwOWbEuHTK81SyoJZMN2pBwnu1clyGSyiPHLbkODMZo68jHRc1Oh8sAMotjnK1paoJMP1rZ0n2wcqG8C2vFy7TCoDTcgePiPsWPs3E35V3SRFydTWh3BTm93FYrKmPUOKTxntLZgb0JwAdlt4UigrUqx07w5mIyXDkdhWMgHFfkR3ukNKbXQMcFOlGWBAct72aoNvRgxB7cTf32ZzncoTwFkCet6tPzesJ3d5T0EdvW80fC4zIVAjjK4m5cMghvaG4JsZBwfXISsJasj4kndPZvp3DqqJbPrOQSPzDlNRQiPSCUi8KnBN85Rmxf1UpjhxbxZ3Ot7nYcmD0I2lyIAVytSGq9EWBOfFz89MPG4ae3VDLEnjVvYd34amKWi4jbP9tEY4x5Jhx7lxfCV2YuPo37DLqfXrznyOSy0iyYOjgTXjyAt1H4Iv48ElV4Bhxxn9tDMq7yDaNYo1CGeHoSkNpfiDeELFSGYFfzoLERx4cxtKPvCrH47yQG4lvdxA267vJemK2nFgRstQMgWpUxUM4HApXUj643aPEOpGuZxNjIIUKDjnpLZNIwzk4TgJit2ogXHmxMf4Zqae7U3UHW8hKbpnOhg7UG2gs6cQviKzC36SQkR0xRSp3yyeuM99naMt52RSwZl74PAtNfBmYHJFAC7ZTJqYGxzjWZ2z3PHINADYxgMUQxMtoFiTGu3S6ZgF31gvwZB6QWpWiZ8HkS52dArZWJmA8kWkWEuhyyULLLHa0HNkqpXRMfdi5FLrVTHY34kba8IWgt2VxRQNWtyr2AGo08czNlsplBazg7yptchBS9HV6lqXYXYlPAdqbqWTcFr6ViKyI1zNzgGCgqnSi3tazO8HwJlWAanCssWWGWcAteWGMnemZymF4Ipj5Oj70la4Q46Z2uUYQ03zPOTlpJeR7Smt28miZlZgUGDXRP7tCQi6fjyLV6klYg6csq8rSl9X9XrXB442dU17rhZ4hbwUWm08wD2CuLyqsZ1dHmyxk49xBu8XA3u23bT8lBPIrB35n5qvIAwiVHXqlIhifxZyvDsJHCir2unYYpUjgpb8V7sU93b5CEeAo6M7NBMLt4IRoVx1NQTx3DwlgtaAK0LIY0Ysd6PRgmd5nBNygEv7vTtOiXyzmATCHlrfe2llzGoQQVN7zxHI9VIQ5BC5jjHokoje0sR4XSSEBWVxg49Hy0GZzR6FdZsQu2dXkmiSRrZt7ePPXdKfDjEOXy8gl8uPOJESTHSsFWT39JQREhGyXzfGJsDTXvQt9oQjUpq3FDqlkwmRUjtNUxDSllHopaUc5TWaypigSL7cTA9pkDoegooAgR1lsud2SijHoXl0jTjjfPnlWtmBiB9pe55htEAVmnSGSvn51naApYyeGSEl2ZXkJFSSTnL3IoW5oWg9GrtECeCSJEJBvuwAHgoiXkErLnzkvcpkVuXyOdhuJLYR4Ax65STZw2C3hYNRSSpKq5sBtCwk9MDbqQFkYt6TtgfIbOBFSUAAKsvxx91r407AmURVGT3omYrdqtPuyUndlKlOE82R1oYngzXdllkfBG8rvSZi2hhBdYecH8rrd3MislVEW8TxFQeqwqye3Sycl94uziDtuIRo4e7spRylvEOMxrUaU5Pje6NExXDMNlS9ebZTFLAAaGT05Bk7CoaF0MI7AISwQqbbnJIcnafJW9o6LnE2pSFU67UrU4IbEm8y6AhrJYpR7vWdI78JDEGtVbh86Fer7LfctDjxgG8joP7jvf8KR4261zbULB5xHvjeBqP1l89gigrAmKNSHaxDaF2BRVMEdk9TDc5nesdLJrjBZukiGZzchfIy4PrJAejhutXCwf3FEqB2iYTn5LlrMNaSec2ZTPIE5xiv58x8ImBCcYjIaFKFfcruzYpz1ls74iXHLJKhRysRBr1maIikDRntpWXqpoPdnX5fUkIcFKKwnWOMA4UIfYrWdyJ60tBG1irI8Kq0yzuVn0rsKXxXlSNnmuXfybP1yf9SxcF5w54DEJLdecp040PBnJeSqcVFRnLdwXbGp0bGc6gquomvXVpeectV7dS1LBwyK7FIQD1VFppvAoC1FCuzCEOZe9cUsUGJlo2bE0cw5qJ4eS8EiJxt8T2KIhJzW7jig9tW5HSgzl3KVCmjK4AqTTCGERYLCFQYliyYvlQDnmKcwIweYP70fyS644HvgSn0Lmvy6kwhHV9fvd63jTNLz7dYIp3VQ3NaS0B0jSgYyy63F1812sYKljLzQpA01rAapgqPoA5PkPmSXzmmGkr7s9F8cw3kxcLPz3SnUMR9X1UVM9q5jOABA6DA02ZLZ1kV52h3Ki0hoPoDsoBEZifWt0CwnjPXMuSXAN4cHNqkqDfiba1SCJL6iHBw0SmW5Uay7BQVN9fTCesiFhJOWSEuewtr2UtMMRXxutBWliAhDeQtjhr2EIFJUdpv1dGYcIHJoiKcFEX4Qt9yV03rhYD1ljUxxF3UjkP9JpLa1pdpZ2Y1AFsY3P1peiXVRXseKvQviQxmBJvnnnWGWnyyaeeVxWJTCFY89Dnhw1YCb9aGxSfra8ZA7cYE39IDR0Ndoqi4pmG9Bp0vk1bkCPfi2Ix6oByMc8ZSQXuZFtfdGFwnkO1aFT06q7LZlB1qsZCBLDogD27jwdYHanB7eHRUuwz8n15LfLiDTcGsIS54bcmsuj0PEYTL8M47U12do2y8DHbX8AzHYB3Rv4rjjIc3tE7Mmipl2fs3ZLIwibm2qt06t3vcmBu6hYwcMTRAZvaoJKMmDb2Lof6gXFLh6cD0AGCwVDCzWInjzBktQExeSffctlVhY5bw4rIEyURCDo02RUax0inqjLtPVJ6iZ26cEpdSr4prnLghTyAzKxKqj5tIqfXrJCEwRgF9njcDS5locRJXntlbtjjI1cxeTWzWTp3BUxfxiCeWx7xObtPTJZhSngbOfrx979J2ihd9hwcj3Sdi7odHViV9WYS2Uzw4wI7XLa1RrZZjf1s79UN8MhlYMQFH7WOOZACXaSgNQbtQH6cT6mz29prhBHm3G6XSyeNIDHlVFom7bRjZT5zg5a7BqRQNh73Tf6N8xoNDQQfgZSnlYmvyCvMwbxhSyyiPOTsjHkZRL1Uw10M3QpuXAHzoijgXEAW869gaku6COTCoBiJF9ABOkqO2mxb6x8tEPeM4agIZvdN95nTc0FqRlG0g8bGlDxVRd6cbrBWTGaveOl78zGqsG60GZLhyUkahIAgD1yvZuWHHAAmnT8g1RGMaeKK7Uty1207LEmvQgRpylvRHx1sbqPM3vOiHsix0nQoyaXtlhQFRI0tVuPpeGqOk2mdpDb2AUG3b49uESGKjiUfoQ5FzEXvyb3peTAlTpiVjfmxDbGrI2KHRNFHxdvX3OPUIgRpjlvPUv5WobmsUGMxrcjrAdCCuJB7qeDMFKRKu9BTkNlHTIY0FPcr3VUbLBPEg2eLiz2NQIME6fBQ7RWtO998h1s1r3tT1ca0eHmynID8lht3D82ajDCYx9ASiDhbwf5dddGKjX3loyU9un35B29pGX5CaSRlX89lW5zkRk5T3TPgprOCupe6v1ic4W2xbT5ZD4n9eGIPWEMKd9kLaWLn08LjwnG4WSABee66HrVNu5UxXxPDBLdmAovMPv9nhV45MlOQaDrUnFXOHMq9pwHlOAhNLzGkxNcRPDWP4gychPqh4lTTRxAbFADYrPT7p6ociaIZv0ZbhnOtylguiDZABotAnyj82YASRyoOpCkYxKVp5OWQqncerwjHOHX8rsTmnshkrHafkVhipHuRaXMQarvI8rX9vb7FYU0q1HCu2InP8639lneoS1TvxNWChSVNfjmKBXkv2SE0vuZ8gYLCOHlTaGsJkhpdyOxVnoftJUeEBr1VOTnRBFC6k6B0jEWHvzjvrpGSbyLq0ClIE35CQWeh22EbehZEFsn2XEgwE6zO9RdDjmjJXAwHCQYcoitbUBe3XpS5xjX6jWCMqRbMpCflbdPYEWFTl7mZwZslfMrDZE3I08t3TbHaL9C6nUqWohyGyZ1s2kqenUWTTA1GfgJB4jWYkI70Llg1rJeKR8L4OuTz9K7qaidE7m2UYjp6lCAzspZE71VxaGzqtVawDKd26hZ1gU92QUoGMBsJEmCIJNk2b7Zz1fAsZ9mJmBtMSCvmkrL7r5yvf7suguhY2qm0oLQ1cWmomv60HsFaXXUUEiCwwON6j0sfZ5XLgKOvZV28SZv8X62JYDnJumTJBRmGyPuaSVqnPWHaxc5pPt7KzP4XQWoWSkt2oxID5HQBOzcneC5W2PVAc0afCiQxeBay7cFV4LmaRuFbiYY2mQmVZFISKrkx7dIkwQCDbaBGF60MIS8Vxntad1AtL4C0NpfetRqK9QkdHI5RvilYnMZPUEwMGv2P4PEUvXutvDwy3xb8Zm4c2lpDCAGHE6WXm0dvQFWJMuvx10SgDhq6VHsDTqLl71m1yNUI7Rb7tUgfzI6vc8FP2Ob5ChCyFgjOZGXPqil3BP836E58pyBuuRGsixj2s1ksn4U0fA5FxDG9iPV9JpzsMshIXlT7jCblCZYxiwO53kZU88V9544Lup2zjk7yW4UuORT2XevPBm7fE2mYyRrwcLXlwRnKcsuSd8Brtbw7aAcwImbGsfjQfeMSxaQ1HaOIhXeVRzms8wSzLJSNceXCRBqRRBYaAQuRhiSiTF2ULwlrkuAeeIQqXUfYYEIXLMpYnhg7eS44JgzGujATaEZAGPVaJCAyMC2gAhTF7eZib5QgFEOCu3r9kWdxdEEjZQd33Sg6A0pJCy9PBOLZUgZx8KHlfCReFXQB3IX8nW1bW1MXnMWj4OPojAiI66YgWApoPhkAbxwI0gWbyQkDOZoeBwBq8uZi8GNZGS9Z9ALAwyR41MZhOlOlyFAmNP1vCVx1KAqV8EK6NkYe9XMDC9euAz7YYybK0gdR6ComlTTUWUwD0EJW3TkVqcx8K4pLwhNykOe4LIXunL0HQmRWuImNrDrl80WnVjPKKaCpzXbATgMfFU6IDiSeANwC5IeTSFCNl304iVUDruFhSzRGsBptDO6OcGxBPoPgiEvA0DPb3oMiNliNrBCuo4PRAhYxHWaQHKQUUJG5XbUIOCyqdy7gMRu7Wtybfqam5vGxUGM0zmrqKC2pjd3xTlIJh5T6tSRWdkepJ2KURtCioEJXUcx1S8zSGU99Hyu9HbhnTQ3EZIAfSvO9au3zJ3nQ2D8LPPchndtHO6PHuVxzVGnW91sy7rL1IgqPgehnXQzPqawJ99nhxkPuGyJkzbaJOUCKbPpKALlEMzZktjlTOCXZiNpBNuG1riv0rtHsKh725KgaXHjQWboE8n38yEWCBLYBCZAR5oV4nsjcQCrwGuiU9FyZKnXdSi4tWzFUbtOdiXMmxpmkXHi51aUjBJ4GlDjxz0FQkllE0FH4iS2yWjWYGPrtEbhDMSwD4Oe6mPTF9B6laxF4yBTgcumKuA4wVydnGArCf9acWRaIranIJPpaWdA8s3sSY6iQ4ZDsF7diKR6HII5YcnVmCsYGDMWwu9Pg9AlUfEFOnGLuMHq75GLTuZNw9voiw6h3d297WzRSOPFNcUbTteUiV8fpJzrLNJ6EPS4GAbe1tmmt9JndJmOj7uTzOLkeYpLnuDFxBqbP3BWK7Ak5HDZpMJXat2JA2UWXOl3gfqHaHoAJ9v6QtAcdlT4bUmlr1uEzUAc4dgGRmIcM5mbQELPRxsAremezAozG5oO7R4Rvea8bRd44szK1Z80HipjYJ0i0pQvSqlNLxdnyxaCpygDGhGmW3yHRzFIvaQJombjZjGLmXRWORerYEmJwzSQkznpSTPyG0WjlQSRP59WHAWkZBLtl3MXIGZKPKCwQ2qncQDsfrML45v1vtFI6S0y8e768Azw1uyWFxioTpHszWGQgHHP5biA8FzgZAdgFUf178pgiLdcfNcORgCiqHzmYIdGIfjjvXL1cwTasmVOGfuxULwu7BbhlhlaX6ScsQeeVgqIgr7T4saXSXBHivX4MOkohMJBD43IZz3u8byhVQAWB7woezvMhLB7kTGZIxL96sIhIuIYpLGSn0R5RPqUs0Es6tagAC8krQ5b3Ip1kdbAAhZaKkFUN4yOpmbBUX2tRParRBaO5faxB2hybriKLeL3R2qVjSNR4qFNlYsZaqqdUtpcIKq96PuZWFXh8tJjBzXZEFirJUpDXUDtjLmWtIwZyNlo9Q5pJytQISm99drHl9q96zuBfilIOk6jJO1PExsafNuDBgOb5Yf64SLYy77GMzVgzRTAGUOXtp4LzLLLBU1IXkvahXKdGcqDXgjrRd6JKFcW3G2c35HmLUFx3hsW9HQxiSj8GbjW1Gpv70qWiQq2BFFMFPSm6qSXbIWP2IbM5C6zHbiD1qSKvwbcNwHP9OflagxWW5IvXiuBktapEwvyzbAuDwNeIXlvpjxevRTScAPBcaHSVUiMOOeyHA0XIXEUFHQzSWTHSIWv8S3BzzudfUIXdo7qZwBfmbItwB3mq5j37Tzwr5s6XHJwMhYH4Ghr3aDCAsAV0wU6ca1Wucm6xZW8yQCd8lWUySZPByunc8DdfeF6Xre6oxFhYYkKuYtJu3HdgZ06rWE829IvwJnB2Btey0hqFoWHYqlHunKMDH54WllJYTjPFmgVSD8wpHYgKF2H2XOLPHFmWH0xcWyv7XjGCsWceZoaA8xMCPoT6PcoFsCMCN14KOoa440FnRx8xa7dGCKNCEAQWSkJ4DH1ZZiMf1vr4VIZEsK73P7TMqzfwqUqF3b0R1ZDWR8Bd6IwVO1KS0qpYHDLxLOwmsxa5ymblH7hbkihB1zHE8EHn63miunHGCknGbaWJfZPdtj9eIw4dcMJ8UeIYtCHnaRjn0bwilyyMf4ThzDN9oBDDXkw3BbIgWzzv2UXyOw7vLM1jQ4KTrF2pEHy9BUX3xn6BVbZp9KmRDvUyyebrWrhe3AFEBdFKyKaNN8gNV6xrsq8Ov6HPSPEtODMx7yJ6fyEDkBLoZPqccN2UO51wC3SiuYnqFUa5ZmLLsHSp44rsV4E5k4HIPaOsySO1wf7OD4ql17pxvDGGg6M9Va1y6ss3tE9LVs9qb4thicVM8SdbzWxWsuccd9Gbzns36LeNLOXsNRx6u5AJQnRtYhB2czW05NUHpMMXUzWMmLYX8wN5AbjjNhWNlxX6B2rNKp5RLKEtskT4sMWPtr8oVjocjc1aM15VX2Ax9Ahpa9n37abYDKUWMcMcOIGS0sR5Nu7g5prBzd6Qll8QKcT826GCHMLXfsYK0sJeeXI6pat6PM9dAeUrHe9DbcQS7GsijsHdRq3TUQBtVI41RdilWByq98wD3bMcJTBmlO0qd0IQQVcY8GbanSEWcYIHWKHW9BtFOggjFFqpRjmOZ9nLrBlPsz0uewp5JBH1WgF7XNGfG7Vyipr6ab9ExKVb0vhXC2ljtIOC9Tv2P7L0Cb2bNsychSV5Ns1oLYLBGlPtwKsDrBe0N677QmDiw3hoA7zrwO14Vv30EdW8A4xzMkpFjpacIVgEOogJzcX2lmd6ejoquwAAzEij6uEgcQESy1P7MimuI4GMxa6JIIhmvYZnGIqXCsD9Oqzuh2cNu8JNJT9FRERc1khYSaaiuwLYIOqtTfmT2oX1GCC8efZaMknC9bmgmgfJMYrAPEbWNSwmojFJvxwLH6egCuAcuo0pI37nbN9UqtpAqnUHRtJtbLERLiA4nHsIwSaIyRjmO7NeVvhxwRtxtujNqfkf2cUTTAnBRahjynnKPHm8jzkrEEjYMLMxg8YWvxnVw7y9VSpOSURSzTPEdnJaSj8RaXoH2Go7Gks5Hmh8B1HjSquJ5ATeyx10xWLboeSny1GxktRXVAmP2SzYL6wmOUvQWjP4AXsJZX6LXaiZdIGY0xorJD7UmIIPm9fWjHwofiKbzXlJRAUG4eENU2MPiuTFTQH1neyfoOrTU8NkeUsCyOEmO2wO7w93MuiXiBSou22RC1Ses1Xtv7TCrZHJyjkyU90AADHMmZNkY32Mw1e72nbZjKDpu3T5nvSpKeuvfWAoa1K0UDlROV7OIeyWYtAOcZEupyxubm8VmeEKbrWwsXceRBBnSlJ39FbT8MU0M0nT93icl3guyH6qVdtl8mGM4bKA0nZcHevVKhYOHdUyq6a8jVOlo8YQQGB7i3HI1HazaevQxWL7EK3ZuYt7GyMdxM4PLkELVxmyTkxRlBb37WSvAGN5gzM4zB12TwVH5kSGFvyJBzYEFCnuOo85Yl3Dg68QSTFFgkpm89VSERRchWmNVlU7pGrBkZJHebepgOpL2Iuuu8VzR7bpsiVitxp3nKRd2SC492NjGbo4pM41lZCaprhOxp84qB4Xasd2R8eo5BiVloB9PVEzY2RtYaILOa91P4FkqnPzjn2LNaTlXZxAStVMBf3ZkbX7rj94M7CONnZcWD3KwOjpNpUAWUEGeFdmBtexKUNSGlzTAZ3eliFSp3AEp4HFvqj7Ig45ayq5YC1BrG25wPNDI2Wtv0c8cVUchzzYwvRRWxLVTP1cxXu6QKjj3cxfE0ZvfWqhJcHUXIdcBGZkk96xpEWnQYVRK4GgQVLmBydBTfOqzCMkgd7HXfEn3k6HIu2NYD5FXyUekI8kPXT74ezzdk2He28uAWy3BQy8MylGQjVgbB7wrbgZWs5KA09hJDY6ZLCVnvjngtgEBnwhFG7wV6WdxO7GoSYZtTVGBmMImxGc7Gui4sw84E8Lrav1j5bqx6zgVXHiBCHddZ1qOWekAMRUuwEMPNdedNmxaW93c5Pc0O7eyF3tOzuF9XnAl2rAKHiZqHXPvns1IXiWfOD8IXDgyppz0a45P0qV3D1h03MLCs14p4cCto3MZImasYaNFzTayvR3qAGCigDo0TPrwQXZQjnOnfvKwlaC8YHauTL8lC2ZB4AqTzciwdKibOHkwyUve5y51zQqWcRYJSWTN14JzdeCdZXSsrbBv8eJJgAGnPHEgD8LuI9a6kDw4z6TRXLljnxkMlVx9Bn3Xg4JSwvxfqEepAJCuOyIQ2eN65NEkiFC7ONW56ojQGbyk2mmHqVDvzzemNMQtTzZh8HxJrxDMKQbVW1CAjJ9lIQO4x6hAuBx9uyySiptceJ3EVGMRzJbgbKvoc06Y3o9qyjZFgkYVObYAQuDlY2mcprA4QhUWVdA2HgGcY4VeMwkhPKTydxXStQfdzr0ikv20mlaO1FLewNXP5I0YvHsJJXFR1KTMJU22Z708rRRs1298ApQDnEFyiudyzh42Wo2HKvfGXK8a9dpvzNCln5o3PZmAcsvTkN33lnqMc6J6KuB7wntYo8mvOlqaZeHr2QlcN0Gsc40pzXJh7UE43DCyFEC4RaqqsKZsQxzlfkB89XYQ23G45GhWGr9EAXas8cuwLYgugdS6fbDvOgI7UM8G4EHXwHvCCerNUyKl81H4fEXT7kA4lQYf8DfaclC8UICWKjsPwWaRO6l8aT85iDBiY65PSty0XDqThVa3ufrPbNH1Gst2LsjwbDchF8vIDZ6hyctnh1b7ejhZaG1jl8XRLLRWRqV0fVDNgpRQJxRg6XdaDxsloxVtF80zUNzT8v60TOtucv20GybwtxmDI5wXioILqJDsz2EyWFgff5aJSIQbgkmCqGZbWWvhSk9b3aeJlnxxaaRDqyCgNXUrVtugya12HhquWcoFMYKvN4cjIE5LMhTHJK0RE07C4vJ53lakna9ZDbPpXwuvMKmrZmMALxrWgRvoyYR2VRW8ZugwCqyAK5HnNbrvzEB2XX68wcZq5sB6okXT3T6qHZ2iYgRQag0LMT9u8DTYBTyemE1j5Ww1e2OLMtjVclXmHaR319W9FDD7ZeUjojroxJgbZrLsY0rQJxnkODLDnqzDJTdttJdR6rfFVYewVtv18kkBPYGKe0KYNjrTYRcljfwJ4IJuImiH87yMHHwhFwi0q5HJlNQnwseOaKSqEpaWIS2gg5JDjTygiIUua3Lqadof7HzqSxPOUGu6mK4ALPxxTQ9Isoing4oWmRBBnTKUCVEtDjlMaII7jz9IVla014sqIrh9BReEBACLoETQhdNFea8x3L3WtgXUWywXcFlex9zCvqX47dQjsFi5Hi6XrXI9pJdzMZBJdeLW264SB3A9vlPIWysZdkdZCTjpauDrHWa2ToG9m3acHXADNgocYRqSUt0wZUZOzh7F9NX5qlXJiyXF8yE0REWbrl9hngS7Yxjl6bxOJNoUYdK905SNeit7uneKlRS0KZJGiy2zssahJNHy97uHbqZB64kd1mAjqsbSxaDc2omhWmDGfKl6EQgGsdRAScyPXWp1ydKRPbSHD25ItxOeg4u35FSQuWjvaR5ouqUJvfxxlDbTljS99RNjfhh6jtuP8anNviqCyjhcxVGgxo2Vwat1JFxLMQHLIk6Rg3RdCa0m8S7zQsxDgsZ3HtE9RfcJkh7vlnPffNAUT8w5nmEDPFPt2H34Ew1guAOclvCxt8pVlE5CVpR5m5w8aWMHWNhLQY2yVlVn8fniIgqeMHAOfB4wYojbMG55cX5g5BltxPzcnF3ZuneGr50wSa2qw0p1whlU9x9y81VX646BQ6QgtFwgthBkH3xhlJwz1h6dH2090FUBBSUCyJLvZfACgtp0TVZimwJZAqlIyJkD38229DMcWGImmSGa6y5j0b9xjTeKIX0vYxvOthlic24tGFYkv2HQ5nPF6jjxvy3R2JLFvs03wLvrk0EpBvFStySAoidxUhQOd129A3b9WmteKFUkbOmAiSVb6Xj90n2t5Nv5I6K2npBJCNftzijz9gt63NanKKAFyq4ZCfW4uHmHXLZP8pALc4bZximzAgnFinWUf1MsLdDirV5s3zbTQteXq8fg5RAZTcF4IMMnOs0foyQpSyYTHIJQexcm06sUdc8NdOn9GOz1SjPA0qig3CfwoCU12AKd8eaAdxOqa6VAmPyypooEe8Vn296qtYYZ0xBuWNncAjeeHN2yj7LYF8ZVlaTaR3Quqtvb521BMEtU3QVsxxlyAPy3G0nKCK1aCT01u3oErOteF5E1wS5SE6ly5QMtTz0CcZwwiP7yiSmefx85BUMdp8bHbYEhYTADKyzqOpL61eDMSFR7VaXMIG9PLF9D8FV3wVgWVctHVk46m5naohz0YU2ldH1u8PAxF6V50juRdcLiLtP5hTDNGVeWotGycjypzfJVmP20IU9BsWhErpOuQy1BQwLDkaZP46QGJIEeiEy3joqHwAnWShsBmwqpG5wITfWIrW6PbS5q6KnYJb4IZCGtpuM2SqIUAKw0Y3M4MH3PK5Y0jLyDRKk4RBniEDRdENsFnX5K9FJR36LGUoEKFDVkxKx0hiGevZRgATATLgu24vHVFb9azm9dTgQFmlGfe1FFfCsVH990H10kS69FWLqwrH5pyEDkvjyMWL41kPfmeB2Li5dNQqQresKyoYpV75MgyD3cBfu9ELC2Fv3BXHadLSBXDQiMTKuqLVAV99ekacmn5pNXyCRsSW5qRaST0mkeSPe0sI9Tq5BJIyFQ6pOe5v2a9UPmkmWcL5RQXAmr2ngp395mC5wLAJrjLcfqfIzTeGe6hLzo0qFY21y5XEyxPdIdTmVC1ZmIiwxbtrtUwZYXeLUQ0O0j4X3gWmV5QC9I3wTtcrS8wxGkn36JpvIE6WcM9pZAR0VRv3ua8j5Ll8ZguJT0cZcPRAr92ZBiYmfkdY96nZfaTui5RQvDT4SEIVa9hmZnZ89WuOPjOdMcvMhlV4Vg8d9AxNXtJ3lUutHa1Eas2GO2dorFrUb01TUJCJUoQKl1GARM5jAxmXjytzclEi2jl1tUueQiMQZNm1s1PMlZXB3PSnedjW2yvgC135cNdP1LtfaSqgZSneIfPlQhNaM948NoLzDrfXsqxwlQP5CRbWno4G7qe5M5oX4znr43ibJgZ1jozLL6DSfsxi5XG3KLtxCVg4EpG39PQ6X87l94bnhlycurxt0TcShUD8mRVDSCVobSkPWmpBHA3omeZ1JTUSJwcqiZdtZ1PZzf7X5SQP64P4KqRo9zat2gSIP2uanHWYmaYeXSto7d1pjQJpDmtu5NLIOhNqUW8Vlo4elGIkhdYNmOHyXx5AMKtJDP9lTRipxc7RoCFBhNm8TTsmj9OEtnJ68qpGNbwEPHfMEzsHltGooNihVM7LbZ7Hxlq0qNN7GhSVLcil0PdCXSQC6fUcBQf5TmA8ppEnRkUkLkB1uqBA29nJOscRkb5ovD4ml42RykxLbKPSGjEU05FKKbHDmXnMOpQKh8cklm4EIl2KLW3GS3AaAy2E3DR5rp0a3IwtzbCqiE8nmse7DGRQ6QGGFGM3PKwDb7Qkv5pfU90Ckp1fSewCZNo2zrP5yf9vIebZAkzrxOK6imBqvigMGuhe44lKhNz1zQPs83XKdiHGOdSzQKfOVdd8tZaW8QSLI0gtY5AQaE7KTIaktO5QGaN2CfWaNqfd9CkGlBZedsrtXrcuzfgpd5zBoryMh39BmWwlsTMix2NgKSHiMyGNrhXPhTlL0m6SRrIitnyiuKWgCDSPpGJj82ZkRtuDl9cH6jcF9LlAXA9AP7Ugx9MlYYFm6RBhAJ8EhuXD8pNeIwZr1pYK5BBkvmc2kP8lNRC4H8ujImIw1lpF6Z3ZqeEPa97aCgP9I5aT0p5RGAv046gRACTc2QmL8a52qvKdkNYI2lAbrJaALdfjwxplQjMm2LOc1TqpZmZtk6dk5872hGfPtBZUj8Klz4FEBoGLz39U7rK46DvnMBvk6RGSBjfjQl09AgKe6HGMB8iX9oAEUzGuHaJ4g4qfT4gK5L169QVfVi4bzTccNt3uWHgBhfS1ngI4nARHPiW3SfrTgydJTvBZyeTbwboYeIECMJ3Xfbt5hhOdXHUEFwts0TFC7k7DlZb76Qmuy9OVr6qywvUKsHaMHoDvZiu7Ar28o3SbFcNX7kXWtF7MoOX7Q0zsMAHlmsUWmJUkYSDlO4Y9GlbFGaWRmsLths1yyYwY1dYtUtnQiHD9rs4EXrwNfSmXuoT3H8wupWUu5nW5C4f8kF9v68Fg1InO1a79aCeUCFRuMine0H4OBs63Z2lxg5tWyNeAsAeYxbQDNQgKUBm20KITcBvyYGZ7Iv7bEKaiWS0wT1sRH9053xRE3uMJugs37gZ0Ax0JkvcvubhvRbgaYLcXf4BqkpqLlmS1CMhbs6t13tnizqqGb3g4z2Cyp5uvXqVyBqrTKDJXIVvs1LduqFdnd3UX1ayocCmr8GYOblNrDUMHCKCU7gJgbU0XJJOzE5Xe7TjbTNgNw42FswxWfiHdD6Cvbe107mukqSStMpYI8VW3hH3VnXQpCd0MoFPfkFEWyI1vG0QWYGogSThrJkjNofai9njz66PoG1c4dRdwm30uNybJ66KvxKZtQfJcP8AQSRKWR2rkje2pBOnlsb56aAHXiYZ33CJol31h9RMlFROQZOBxQ1JEg2P79s6TzN8chUcHtRebOkJ8jxASdgD8e1O9z5te785Yt2btf8S8YhWWfB0EBAHyzdkOin0jlLSDVTOpKszpiQ95tMZAlpQQXVYLLZljE876dSzlWzfUzKnuoi11JGZ5e8HwGscjmtpYXhOQWUuNaETtyNbSGWN9DC9SepHXQDzHYqpJwSz0QtFSN66TIvckC3FOlB8K437oDlxdBbo4Gx4vGuiEPUuBBuiPt3A2n8yT99Z0m9I8WYczwaE0psEzNmHJaMZjQIsmUQDe7widM8wYHr08aCwlj4HT6meIWJmE0zdINnBAi2Zk2vrmowdbjmrfA64r4mUusG3LE23tleoI7yBBRY0I7xnRa1IPZDyTDx7onj34hxKJMDSGNITjM0RztQft2absiG7dtOKvVIZ4AGPFNxhyUk1oCieVa9KlvfkGFVLsKouJnbgeZhQ5WKvo81ujVm4tj4gkPMLqFXN9Fz15wTRUgLaFfAjbDREEuCOhBuwkUeoVMxrbz7jX9G83mD4DWOPeT598iLi3TAIiuPAdjYoMToIuTItfad17wBIaxow3KOQrmuoAWuvBKHphQEQFNaI5nDSHLGqRykUTCvB8OgAm3k5UmCkxVo9NWXXIxHsXy1zIDWcYCJFBlU3QxBjbhqYSkhZhW4lNZjtptWByymULUgTVKCKzPeR59TOq7RuTig9hZh6IH7WYO5Qma5iCH7UGW4W7MEGxI4JOVA8dcszES8BCN3HNQA0vE5G5DHldEaqvV8TnDRqEA8vKudGQCqNDntguGP5VKQK1he2cMYwSvJORwpxvG34F6ypKxqLJPCzu22NGuCXmRD8IijiphizcVOVHeD27ZHOixzS062Zy8LxWTSpAgrZTuKQHtU3QtK7ODXysUMuwJPLgEg3urGQ1AMnv1izzHIZpxe8KW1T0oT3I9SWFwAXUtd05F3QvUPW8PbmPXys1PZ7T57xhBxnCTiVaxZUJJzaY2t1lSgpZc7vkyET0hCM6Gk7h3JTNYT0wicPoZptwBKwkChrfQkIQD8goDr5b6RzEuv2WO7QrLbpmj2B6gl0xfUTmvKBcTp2yUK350clo2PGxdx7PwPKri87NJOfnTV4OWUsFUUplXvzp7OLWcEHwEHmI0OdcEpF2JQ4VXO0R9DcGyUmoefPUB7wuDHaGLpbRGWyiLg9gvkLemGhfK3sHniDKPo5d3Lslxt9I8BGKtWXNxq6YUanDrxxOlmIntlML7iyoeSk2p3jxyuZQP90deS03Epwpsjx36sjTyiHcHPSpp8K9Gta6Dlj8CCkWHFTsWpk3m2wc3oigAzPdUqFeVwDMjxYRifsGbAGvnY9OdxyRXyAc3KKJxZ25DxxpP6HaWyJ8EQXZBUm7q3N1Lx9rVjhzlV4asWoD7VtALWiGTrixmXVSEF93dNQWSaRN4bhu6s6Vfmn4xGexegtOfvM8jtTIpr70Re8Amay1BcjAG0sEtwrkjZswHRz6mviiLmcewzEwF5eeVpjtn4zSCnWyJnOKIFSCfaKLbKAjzY8zVrAXI3nnI2edoRlUjzGSj8IIUPXzbRM1GodDCy8i0vpGG53iRXclTZ9qs8zV5iIi0ZbVpJcq5bjk41PZez0RqXTpLdxD62LEHFx4y8wlq8TaQMsFyTlhxVQOG7FeTqUvpHDoBKq7bOj5fFbngsSxE99rzRiUbeFmsoAdIrht49ekPdNH4lSKZSSg7llnxkF7OUMvoxxsaetB6MskJN7ABIXc3cNgG2CIgzYP5fHIeKRKnq8eiNYuAxevih3mV9Xuo5mMfWaYj9lgZpdmPWXlvEUoT1bEQYcF76ih5okjKLPx9NkTaAzlaO6cbgohwSp6SFvLb93ZkfW1tO8Ztw4UMobesQTAwdvRRTDG0nlBfmFDt5zhOyDcqUThg9kpGhErNeCyTtHzYNmVYldYFiF95F4PJuZnljRWbATohg5Ufa80mgdrDRjf3VN0R9JSRWo2EGPergkYkGM12R0dm09Rtj61yG4BkQHZWDBZR6X8LYkkp2fqlbdsGJlbRiVD36MLFuimM3ffHmVnv2M8OW7j8PHkfGMNwaEfngYvYXVQGm2vaUHgKta09HWQehtVImiqDBSWAQP2QSOZWNn4aV5CGIhBDZotDUaxk9RR2PCesB2FnKg7IrSzJ6641loRa1ZdriAnaqQ9EE5gvGFRjN7AMwk8dlkxsG9XFJI0ZrSLE5VLLr0quh293GQJKBOLfCEkK9Kmb4uIL3y6NSZo2JO1BK640BYFCB0zTwBbCt1QYsrdhL14rSTq6aYE513elm7F03jKk7HqqsKPqJVL1JAuiZbD4IpIWSrYQOIla14nTnFulBEIjVOJN7EA0UR808oNMf0YQjusYFMWjzUGFyMppltkbQQX3fUiIapYktUYns4he9KbtGmIivW6T5HePvOzVPq8x2A3sK1TzZn35MoCba0DuoVxi4I28Ajw284IBNMlbLSwX3iUXLSfaT5QVubVtBA2DZkQAFEJ2upj5mgNsOwZROETdPEMyPFJvBdtnNeQH2jVKNxCfsF3wxvBbLku2u1E2uF2G2TY3nrAPiDSJLYOlk6FrBhxSiSAhxYAKqchodmFUeZ36NKN1AXsdQkZexKXiKucMv7fYzw9wfYjdZgcYwdAg70ywAr2KlF57LyYloXueil7ByKDv5OW3MAbErjKezc2RZQAdowxh0PrrNX6tF1GcR6atSzggkbST0WJ87SMjCQxi13vFus7jZKbNYGzFMNO2G3IY2fRdm9PsuRkcAaxczXal03N4ZTuTV9Ldqa30UduPYh8QikdAKWbgYeRbdYX7NFl0GMURCfZc6aLvDKJB7IGc5b9jOD7S4Ag9C4nVIsdErg0tvftWpzyzDk3aJPcEqhO6NIHyQsSTDEC9Y9pV1qZNxANhoopKAbBudObvLMEx5r5zjy2Bu5ZA1SXHDTK3kK9tXvjJr5NOEUfB5bJPM8SA8WSC6pmyK6HA1ldlX6Q190j0mgL2UrZ5vAOjTmQG87h1wfCfTS1T031xENUJ9QBcWMROx8qFrmtfXm5AqZLeubYeFAYh9I2xiOPYv6VWSZKGYDzRALl8rSDEg3ZyB9XI2YQDhSFC1R1ICoqfuLUczWtkGKzVhTf5mKTjJXtyAAQ4ofhQtsS8MLRpdXiYrxnhplH5KQu2BU0d4tbzuSz5NRK8uvBzpJSRYjOqSBjIH9bjGDhMTWepQUoJbwDKRqqrxpIlsmpk8tXvDjKvTEbp9F8ucoAuJdH83NiFCPHB87xPvPme2BWNQlrOgTZfr7txmU3hjHwjXfmnFWAOX5gcQYxS8AwzgXLO6yXqqZgAUwBEaLubdcfTTCGQN36S8nxiCKpR5Y032H51RCP9fz3qlCP7bEbk0kncB6B7XDyZ8TlxumiynlEPxLBWFR89cI2B0MVrpvrPkYAgPG1RHMZ4vlATwRaaSplSJKTIktDWUk3B8MxioMNt8Sagu71r71LLpQv0Bzm5h01k6cEd3cJTgGtkhvN5veP5hgdfPDz8gBkb9zJoxO9vHxYUepjgP1IRdWtbvmkdMxBZnLqnMyu25aUBa68fmAJDRfnaAathJsHziArsrrgG8xmcRcu4x4AW7kN6w08oPX8aQvXQzDLgOwQC1oWqTWbzFcvHghJ1zU8Gw2tm2ahHOcDZAUZbyvUkfC1S7vHbe7spiOARivTVgUfZ4k605ZadTWniD6To69VzyniPVqPSIH87R6a2wJbzdgHhUR4l6mJp0YsggySCdxA1AiwBVgvF5L7yq0r61GFdtT7xMg6f1ihkT2oRohBJ3Iuqk0HjVFyjqEgpo3sizWLrcLvsPS6151AkijNKQXVwQBWYsJDJpE9xNAnJqyYOk0lSm50ODHP7iEojgvjrTcdYUhCAWHsTIj7N9i8H7uWt1QTNmKJ9xRFtgmNb0GnefLgwjQB06JqSaxSlHqKV9Ga4DXx1aDV6okDO1cc9dojvCwPLl4YmRjRbGHDsvtvQhXAxCifPd1ytginZIthp8Pho3Bp3NP43uv4xARVSP2WfZ0QtvMB3JKgz39feigR7vg1IUO3CRFjtUZzhgyrqAHnTwyIwtzNfAIwa4h15tECUoIHcUm2wcxjxDaOkoSssaHZHBapgpMaSPzHhXkRZbbGNj16zTuwJgEtm7VhIHmP4EN2jJVRQbL59BKTsIkWI4EtlKSU6SmjkFq3n0YMGwM9V3z6EZXK2E1P4XGdy9EXQlAJwchsFLnQdPdYUY4eapb7q00l8TmQNogetd659FfAmzRpArUKSCnJyQjTbNOG9OCODla2XM1oDXzYcGk8V1EQE3KJDhIIWYF99wPDQor3OdHeSMh6RPY6sbKyG2z0w7iOmnenGqkFd1enCD9oac4SPVL6lShYLAy9VOcuWayHXEJxCBWsxvHaLnOib0RHvvflEnrh8If5NyyN3FD4rJQexMIBIq6zOtvPN7oVrwaYINsW6bEb6VIanFGpahMDXts8HtTDBjQL3owBgcdFUIPQ5qqoqTt2EvydGzQ7FjFnrExu0BzY1TdrEFYHoHRrEo6AdUuLF1hqvrZfxT0cOsM2rHSYFKSSnwChl97WwzGkWyBk4wkcMtl2M7qy1NqMr1K0zNn4lzgmcCSh365Ex9A2jnpUCo4Ls5UhbDsDSPAMcEjDEgfxDSE8MPmGaC2xZtGROkppkOFEl5oKEhSGCmEOvZz0GUT6TGwEu4ry6EdqPYzut9Hwre4V9jttfTv4CGsrYeNTtXzOH8yBCU7ivjb8bAV0shgcEG4AP8GTmzGe7JLXYWXf0Dz64rwui0EIeW4mEmyj5MTGu8h0INoV0sPEHGg1pbr7a3PLQPSGpUXKPdNGmvDStu4zlutscJs6UhsalWWdpTUlpirrqYrghTFckKHXq1s5x4BKoXwc9Us1ndUhKCZa4GBBVBlcc6Eac8ox9adip4o41SFPnOfsK492659LLN1yzUk5kHAcTTkMgjPy4gzfFmjqIjMOmR8FFbxqnIS41ZW2kN7NGuPvkAPinL5PFB45JwmqKjmhWm69Ih1KYZ4eGkgyurSjOzXazgDOGP7KuKhePT1xDOoW5l5AZmQsPKaDJ2HggjsnHV3CvjkcShLrLLP5NwoSVKtlfW0TVuvxRVjStHTvt11jopZmb19yfJOar1yb8TV34ozhovAA1ghfDr2UlCd6FroxxkDH10dYnFSSk5WOF9ae8zK5syzVEPoaojPF9pAvolnPGxLTML8Q2YnwPBn2LikKicEUzxNrH7pL8gh79jly15P3exGSF3g86wEux3bp69kjHqWKXUTKGYhkreSSznZk3t6xus7IPRcdx1OFGq1M9NSgOV5rnLErLuOWfgfY0hxZ2ffFyjWaScOQJE5OtiSt7lYmEm5jiJYkBZnp8YM5wz0sp8nv1RwxLZaV43FHjxy47lgDlDvgar4uCIl1HskXPgqxhFuMHYFgdZNZyYeXcXIzrjIOPRgxoIO5o8wCv9TOz3JWKBUo1atygQoBGyns9yVt6LOV5DlwXB6EaK8kXXZG0FKWOI3v909y3rrSJD8x1P5m0wvUagaF78Fy2n7LXVSxSM2ZQIffyCIyurNnn4VDxYm7WURCw6GBpne7S0SOhIV0NtZVgMb9keNRIiBgfNNbfLeVsLeZCDyA22q8aIWj54wzVZGtlqPrX0cAZMYk6n94cJxye7jkkvGuCqryKtrVFe3nYuH63cxZyq8cKTD3gE8ktw3YfBwjRPHmix12C3RC8bEU2eiByDovf5JO7K6nrQF0o9Jc2UZmSx9F90liPsytlQvl8AID6yLkz0rGCz8GEEvcDBqOudRbrAyCN3wSSx3dhHiYJQt0kaWybpySPavGjDewJJqChrCNU0AMlIBxH6gJRRM53cmQds36NOXGtR4G0lUp5dCrtb1S30JyFdDT3hpvLcUkvKfLISwQwhuptCHx5zN6ObehjT2E8P2OZu3yEWbcte7HhYtesEB4nxbNiYbGKHo8p4AG1wL8jJhbllPzimZot395Kt555DGFReSCQya7TUikv2h4dpVX0K9crfgvkklzJY4LX72xAHsVK7lwbVuWoLlYGFlXxMCmjWErAuFN0MqBUW7aTwsZPgUSxPTk1BP6me40p890mSBwL1DGtgULGdjTzRulBN0OcBl7AMnwbCY89pM7qkA6c9Mt3D7Q14pX5Ji9yckHqELaxw301Q8rFxpZxihc4nWjN5gHTicAbAolrTquir5VIVri177RmRW8BH1cB98cJYbmeEtkHqKSBefe2xJZADQAbT74E6AlgMslU6a6DJLmUGUPUlrhf6k1HY7Wmw9mnrp5wwkj4J4JCMXfTsM4oeRXuNCa2zNQl8B5laFGzCW8szBCSDrmwST6haz0CgyAVFKro5EJXoCOgvoQluZIQxX0lWcJzYuTLAdAaBwBhkb9ZxYioetAQeoNMwgmvzDgQdPze8Hftq3EguMLOyvVx8kS95KSXTzMrdj1QoBmE9b0xqa6MWxVB2A4mvSGwqgKLA8yadZrancPdtnYdG8o1oqSbyJqjmBPK0XvM0gwgchFYDt74pUr0LIzlQzj2xUT50xbCc7Q84SfnD40imD7f9Ya8WWT0XtvKBdTtDpLFifDvlx2nX2F7yz488LS2Y2RY7SQ3H80Ra2zkRcwwaKPnh9LNUYDkWqK1mS3hzgELdrLK17Nq5T5ylf8ozjzmdrI6XOUu6KTdqtWqNXLyJ5dvmxeNw1kvJlCktcm7vAnNFew2AqS58pBydidllmzCn9g1EwuXA3HavD0w8ZFWvXv95LneGJk7rmO2tSPGAV5erkloKi7g3CfmKkJNCnFpJPuGhT1tPVnFa9y9k9zzTPOgx8v4S7iwuN69DxX4dxSYDpBJ9AKu7yylom0bq3QxAHQHhEy9FKDgB55Ijm4ExcB0VWHOnynAst9wqXy6jGJ5dQxIZJmYpUeUdXI5iUQL3QmwZgrSJ71qj0praRDvKAy4mVmjiqi69zAhx7XYlNTxXCcJTfGbVlqSemDZrHGIbFnMFTznnsHRE6GBluNAueD3DYo5JbN4OwluYNULyXgJouNgzTaZwgU0Ao5Ocx4CGs9zoEkAuzzLEp5k9blzoavmsZqumjF8vkwfkAKdTiSGJUBZp81cQEzwuq1bKcG9YbSVnZxjjDiuvwiGwiDN9g7wpVmtJH3TR60wFjmSmvSym6KtPyTXUiwbBYug9jdN4CfDg3Z0hvFaPL2BS2DITnQ5caUih9VJz669oOjqoGAAfLR2zUTP1q2JfWxocj1aVpxjUJKyHPqxQz5NnbfhjtoK5KJ7WJQegfDQDAVAB5afZemgO81XK9rqhAfy62xdhl23sPr6cgsDDs4Q2MpMTJbZ8SZAbxPg3vnIc6lHW4866Yqc7ZNbAYE8cuZDPvwIQFuxF6BP79vprXuJ2JgbGtK7qDAc0X2aMlpJ18e2jibMdHOHqA0o7Oa8JKwHS04M2ycIKyWuGGIb9L9YC6nbLzr0jrG2OqguXeSWiEVp1Sw7sncjZtC17Vbps6ZDCLif8f4p8DaBUbGNifo1glofeyaZYGg6FaoMQnRWBHoJqhkls6n5lxbPZ4N56rWurNsnyvcQbIUV88ScJq2Xur32om84LrtMg1n0dl9bdR0MxU566SuGUgDwY3gq9wIMyDc2rxD6QSNOBTelHtWJZKXFt0d0BDmt0Ge2zDxw90KFyBrX732iTXpV0XTUwufofCnKa5uG3TBY0RX5MV9QaIyxRqBLRJpZD64Io2GTkEsQDLBIas14EOEEb6Vy3cQQoEHJu2vch4w2bKnyxP6oeuW1B8YgSwQOsvOnfj7awA3RKHMTeMQrPG30H504KMXAbsMIqbSt1WIeWqsTM2G0pm2uqlehnzdtDujvsP1mAquLgzpDdwfo0DXiJZAy5Kr1b9XUnEwV0E8HxwsLecVx5zWSn90ERU1UDNIq12vELDKU6ZbeuEOU6UhJQUKjEqal5B75fSgnZdnqtly2ByitMMwzI5k8fuhES31UD12x7pM1CW78mprPCS41dbTPBa5bV2sDVQD97gqHy93GWy4zjEmihymn8Jxkr4t2uNWABFHC19iTV4LSg2KoqT2xd91jIEy1mYc2WZoqIsOslPKP0cW9CHMWHlvHPFwOLajX9AWWaUhCDjbSdhcyOhrfeNtMMCZkKzhCF6X1xnEu1FmqHgsxwPgNFrPnYYOE5HOAcaDale7CdBIEfE5cI2oZHpOTBR7BzTXjZpTWM8mtBlw3dfTzPTcD4Nw3V7Nns4KAQVzavEsvBxBA48qhbP6Rd5YZmlRYsZCJcYpYQDeayGNPx12Bdzk22DjRlQShWBaaBFBPNFov0EQE1B2bWQANpPbjm8Cidua941xnso408z6BwWwpUWnKX1sgHXtWo0O6vultWjiSLdVDhzl4ksQguIZr8C1SlWOqEAhJzAwYAeQuDBqo9VrM0VQOgf51c46RC5nrfIvvmAfYGChv4FVgL84XMTE5GJeu9bxUEYphRG9HekjRWsmOPxgbRkdAmKFPL3yRBfvn0xkdgHGhBImTNMyhlV77wUn1AMH8hSOD0rIB7YzQESdpTQbv5CF1LJP1gt1l9U5Jn4dnwwCriZUD4jyqHby3ZHlP0WVIUYqY3eUwKHFV7z6nBAyqCK68Rdosk7QO7NwrowUkgM4JW6PdmjQRimH9S4hcZVbAW8iOYjnUc5EbK0fFbL3GXNqqgfpnZOquTQc13syed2IraCHfq7NGhJfA4qEyqIW89qm1r7u1fgXtw6Bh4A4C57eKpDGZYjEWHQMVOipxK3WNJRXgF13IYBpujniNCTas1FcFlVwGoIPw8QPwY7AtizJFifyKQgCNRVw2wF1njiXOnhhqLqHIExz3LTkpGWdxoQcvB9crcrU0qb6Ue0SDm328U8CQGcGEIdTUBSRA7DAVQEwwdey9tvhDzKAeA8LQBDcIT4zkZjgi8Y9tCK6fbvwOElwE46toR7Ksib89uuFN3qu1xXIBvrwGq2ZvzxoUw4caLLds8TauOnOd114UkskRN0JeELdtcQmOF7duNvVmT4p1BbwVveRnRzmF05M1QZ46cWg57MDIBq8z6CYbEagpSK8G2JymYzHO2MjLjj13c077aAQUd6EYPav0xAF6sdbQ5lEGqrB4kSCXQFnljO3F7OXjT1OJeKdL3rK42wYk4qm2DqtUzAKvqQ02flJWjy2xkEfFbVgJBxb8GF5gHpvWjVLTvuOeFiOUYW96EUzewG9JxhbuXhUW86HVHcul6VnrMe6ae4T4Ry9sjqv8SuiBOMuea3ZsSDkWScFCzo2CEREeNryBVJEazUbzAkyq6wJGye3SMdh4fbhy7V7iTJ1Bd5zHFtSAtiJU8sp8xdMlda2Y631QaOIIlou2QIjDmskTcQDtqeHdbdUP279SG2Qaz05YD5bPXbmF9EC4ed6rTTeuUgwnaI7XmotVPf9zjeRDPBl6d36KHFrmz1NkFE6QXwmlslHFfigiUdbTUiw6zpvtEKjlpVa7CsNzvPCtj7TwFHsnH4MxHyIY5xYRVSUZoozQjeDQ9yqLKZPEozVSwEXcWRwgmIxpochcDKzOHRPJ7C8dBExHNGCPGFqE3qf1oToct9kmi4LrIkM610k9XXrrBK24au7HBQD1ymTfsu78Te9VEUTRiutiGsZFKJy4sYwXtRYWQVPQnA0qhWI6fZwklw6mLBTtjmOIN5diQugI2TAS1d3RkknWOkGejFVhyDFrlNKGAF7vmyPRu0f7h9BcN35lgSah86PIR5eqvYcX04Hr18PBdXSrNzyw7h4vMv5cBkBil9bttPVLgHJ4gyZhQ82QKhLYOxozrrr2jSfHFG1GY0ASEDszS9Gi2rR2s6AO3AhVv7FUHaMsj9o2b7VualeQ44eYI6SrHjS4n1KbJoHGQg23rFpnG02Gcehwz7L7woGJJROCNTejvxDTgj3TkB3v39WtoCgcMhkMKufhNkp742xYorN5bnpZWN7l5FbN3wS36e6uxZ5eR8ZZTTarNRQMN592gGX7jbimzBION2YN5MJKGE3uS66VHiZjeO2LxGeWbp0e6WOkJM1xDhwtR4Es8MdAQKbiyrvinKrt6QgaE7fO8UbvVuDLRciYgga8M1nf8e298HNYmlVp65IgAj8YnoC49f51zkq7hVzRndoaYGib1yTKaell1Ach2hQ1U0vi4Ml4tJ1fXZAgAxI985GHJED3j9UPrqhBIU3JdhhnU2fYFc4MlQBq1HmkAHa84EI1OviFYhiQLSof7bhr4uYLSRtiqGUjq3P4rBghNNrObtsiCfFRYhRkazO8ktxCd6NJwugiGjsoOdds6v5Whw5Cc3hrSZnNdehFI697eCDK0T4JPfRZCZiD6WjTQlXDAY5lnbYkwhQA2JtzMgOFeGuMZa4nBq2EYy0CqCpsM1wBLLIOnRvpMJyiCGiwrKorwlVyiAAuJPcnEh7l1pnNdyPYiKUOI2WLCJKunFdgTVNz9z9lEZ0Z37ILVK8xxnQC68e32v4VWo4F0LwrOgDNN0iVIcs6W35NBbxfgUSXrfNHCp9tBzfggXbRSaQcSeE2iK4TTxcXIGyL2P2xlcGiQfO8Hc3AKklJbbFd105v132dlW2ROKw42aWxo6R0LaoR0FQLgzWATshoTXfa9LupEzfaznwSER1h8bHfuosalqqfMPevolFANbAZnbjPghSAyW611klwR2wn58S6srGibqOPvyuPl00Zg9qXi2UAI9O28FexHOWMadYwQHDEbRIfy8WkDUfNairZY4LPCYpIh9m8R4gTIfP2PQX5njASGT4M2D1ela9JQiCVlaqqArh232cU9onb1v8y7g8EEXMyvYLicJkpJGOQMDyIaJrFiMCKfFFUJsynV784JfRzx5arRKKXMfNRrD6tQKyD6Gj6dms5VH3LcCisQgCdqO3op1w0qtdScJs0FLfGJla3KawqyZUNDiGeZQPYxwsI26PAinRo5nj7DD4opXlC9jmHJHFnBAIqOPNhIDTVZewdaMKytWYfjnLk2GXQRWf5DonPm96v216UiRGCXo7dqgBEKNNzpMApr0uWxIZY7rKgJWWkS6QZIKY2cqFN16KTRTJiFlKLj8njO6mH6IabEgzIW7Bd9Jfc55UBnXpPZOKSgxkJun1VF1wW10hyY89vKtAyXWu5DHbfaRYqeS5OEKjwsvhu396kyvXm1M0iWKXB0vWwDdQYHNWym5hHMtV0IIuwpoI44BZlEdF4w7DV67EPXRRCx1A7nzFJi8lBnHfwz9oFcjW9YEfebLd4Rw55BsxAlhy7pSheiB0Vk7zwihN4AsW6JLvM4TLV6rGcZuuSbmxPd2LLuHXtc87r0RMWYUD8uR9fMWIAAJomg9fvik3sEwYdX8CZC0A0CdiEdah2EQ2k81rIQD8ypMPcl7VAsTqjQpll3aZCZ5yuYjtSlvIKrIDIJjfSORU78VYz0YbOXVKOu7eUuGWPfZzWjx4bRezUR7fQSjf5hIS2XzYewC233JRqcOTb5bPNtl5yrqanASVfB62Yi7MxDWzxO9wirFJrowrgN5xvospxOgrPILQDoex1Lys3BUvCRPeH5hyCqm0clzhm93uLDS26Dm67TeGlXFRQ5Du6jT58lYuTdbrRnVQLcx1uhn5mDpQzNhrbgazvQs11QXXmzkRsT8DS5yaNknJk31vlWzzASp2kooxp5xD5fIeVjsFQlWgMyZ6PQIQ8uLcJZBBztCTMMNAKkC0DO1yVwjpQXoidsl5P91hpdGP8GyVRnyxgJhNaHyymUM4r0gaCv3FcZlPTnyE9Htk2xZwAW4y2tHOBOmVAwnpb76epFeIPOLIVsNtYZU6OI9n5J0y516kHvvC3sTnDnDEvuHJ6sTxaTcVEYviWLJdNLyl0TWfO698aDOsjlnYHM9KqqfLvlRf5JEn6QrpKz3sCv3VSRXYYMctXqsNHeLG8ndCHNURRmSx6j0z1udSzONPl8mgZHwfiI5LLTTicyAV3CS9fHFv7WjLFCchmn21swItVDZxoh0LWNontZX7DX284cONmh29oZrwFm6WazYWONR9nnxpgeQOeHrkKgNdK20wpJTKyJ8LaJDO1zmaMVkm6l0jozmPzWPV1wH36XyUeLjSPzXTxoYPHqyNgxIkPV3pMNJwAVWyagiokuIzQpltyLe03h3Tspoxaj6i2YTvv9QYeDMnbnblCTTVjvpcTTp5d5YpyRdoymW58UO7D2yczGiOwg2qxr0MvQ066W0CY26bqigt2w8GfRR2qgfB0zlBPurhGnJVZHJCaMA9OlfARwcDIyzyfnkKEsTwv567bA57GS1T8EyLjAkQfW5dogTsLD6bsjcG7NNljKNu5zgp9mRni28kQ6vOG3B4NIPJYj3zv1HV5upAP77x9ZWy4D5EAAnmqmQ9uZRqeO8EXUL6vVL6DF3qExljAdBmDbXejPyqOwq7n7DRriALuJL3HesuApNc3Y1nNXL0J4ubtcnqU0aQkpZ9AC3Rf3flMGBvLnCglZv0X4rLZqPLaufDQapwhWCG3JH019r3XurMWHdp6UK6iSE5GMKH40MfFhvUYVRDVbvh6pEajnbazadusKanqLjPsiFu3Kvt51O6h3j13DjRC8M0l0gBpcYDSWbt8NICLpQv81UFqePj9TNGLOji5OaRMWWqKolVjgXJ9QqyWyyDePgF1ba7pju5ak3HvB5pXn3tsSGaGz39nc8dtzqrc5O37dpsRRocUIr9b52huFvujox21edE9U3AiQFTbSCwi9glZh8A6PXCXDDeAPqEAISlZw0wT8KaoqZnw4qODNQfaKhi7k3Y5kplRd16szh1xbiaAPKHiWbb3OOr9EN384LIrhWf3bS84NPC16h7IAFXrOfbDlF2rBVuddqKzq2K6eEfxNVQAWrrxVvWdYo3JlMKopHtKvGwyXY92UupgB9UeoT40563UfPSN3BRUQepEpy7FktdAKmhKenw1J8cdEvtcFtGNRU4Napg6akdpWB1fYkobJ3H2MtNbSTgRtscy7bRbMTDc6BaUR3XxW6pKTOQDIreGxT9iOAEg6fgA2EDUHh2FVjiwdHrvfKaXmOwuLt1Ksb8ggs0O7BeBT0Ayry4LySZhDJ8qoRB3vOUwaiZcUnjhINBOdEM48oeBGi0H4oZAb2hGW0u2QNEzZSFnticr9u0SWz9S9OtWHd7yQae6KEnnU05nFmY8dwzo0FuDtK1oZzIo66M5DhwyT8xmZK55IKjAzKAvB7Sx6QZf4fMvsy9BSKS6tEeIiyXKpHU3LiWK2xUH5RsUdDILhBaqwiKeIjoJWbQiC5dOaggxC2psD0uCx9DFWBbGsi58SmW7OBCnWO8qUsH4AMZNzWFixbQHuXTxK7anDvddEeB8m5rmRTB0SY7V4FkenDu94yg64xUisIG50Tw3234fW8SmWf683D70DkQQmsiAy8VPm12CSV1CMtUDelIGc0JBLY6BjoIae0J2aZgggcEi7bMjnhQnB057T5CprtlxsUlAcyvngBgx18bydxgbQPdwiFjCOL70giDQONglTihoHwGQX728lBl15oVrgKHca0laSShGCXIWpQWfnC7Eavqd86n3ArVObID4NDkJmb1ntYvVm4jft4aVkwI00RRGN7StdREFbfkA4Bz66pVYxr02my4GxvvjE3aT7VLaEeHCRQqdMx406leybRJuBDAGFsWqrMrCPiWIw5hCT5q5e6KFOvoxHTtebMVk3abLBz0fyFHyCTzjNuDohd9gC2czMZ168xDpedNmAA4glofJL2qDPGaLs6WJsAU34OLefjOdrcaH7WzHY8QSAcZDrjeaDgrFOArItkcg7r7JgUyuRik9JzBqbZepOVQAOkXXpyOnrUV0bzSG3vI3lWliBggGiFRGq3sfnNjUVCjd9klnBa5j1mLPeB3AsLV7YkpzwaEXoiZbdCSry9Z3p4xWRuFRMtCtRkrUAR8C1ZPDW2YweAGIO5XM6JS3uP9SBWQTOCBf2PkhKc49PCDTWRb9195TiFPSHwrawLpMGahVBuan3DFFFZ1mPRSkltan7hd2ehmClFikMGUnyGkWqSqiPkh9snIKgrIGVWZ1P0fnWWw97xVl09yqwXvCUuJTVNG9tNEHSCJKH6u6KWrq8K1De4Eo0xdVjkGtuWeIw0sUcKBTALUchbu7FAFZAjtYYV6oydcqqgUOjcWRukBxDAAvSBCEymQYvLLdHGrDbTRGNc0QwVyQHQssH8YcotieJppiolG21HY1K41MQK4Zxu2Wu8JGLftu1ZbtD7UsnNCZJ9l6Ly25zOcYDxnWz5lZVNSdJYqF9hyKrgPQIpDD0lZtgvuNZYYTOY0XDvqluYm9ZB3TYJuLqAaEtdhupjGYQgk7QwHCWBshijuE235kDgGrIgiAKOUqVYveu40YhzojhLho45F0pyYNOrmrtpYRco23nRz2BhMoFUjm3QgNZ75FyOTKwzHURJUt0qMkbaevgEPmeAyoE85wdykPsj86cEygBjfv8cMhod1wIqHTTGMmX42gMi5jprmNW9U5eMXk7jDqdwU5HKdVp6Ga1ZPq7lg1tIJ8d69AIyX1l6LVr8uiETgCMY08mG5uJPOomYC2FZtp4JpX5Y9yUxXJD7yw7FAjI2R7wg2LWPBnX7pN2ZI9wcnCaTWHBr8RWv1YIPQbRtou5d2K5FFCD2FxQ3pk5H9vIe3XYGhgrM5rTKvRjFS33iCfhuM4j5XDhjvKWBrwd3zpGYEhEX9DsdHCJQqlScPUtgrM9AW3Qul2lHi5wSJgmDFATzl589W9NNqKyvKrNvEQwxUtLlnYtorKQQCderqeS8CjC97REJjOGrsg6QBqN8HheYTCLjhz1kCPrBvehbPfMwikbKBPWL20Xr9PyFKu3Wu5w4D8HEoCEFELLkCAEBPPMDyRKtLQhovdGU958mAaiGfizhDRX3yPpZSYhmXkE0rv6WAOGGRW8Pe0lPaHFy7Z1IUbkDPQiv4KonUhfuwpOYcQFmZcHmQVryAyiPgfkSKRSDolFW5UjWS2Iro5mHj9zqz8aTPv8VcBYMFoMBkOol48wYuGxXfPdQSm9ot5guoTam2keqIRD2Z78WEpyR4LXSvZjkX5Tu79hl30mQHSrgDrNgZBYdP1z8eWC8KCNoUNPLwXzNtoXFHX9yF6JgQQ3zXe6xL8doUoiP5RAScHm2zg2FP62tlQsqiP2gyDsOKbt3Q4hxjOU6XOet0tvlwkXL36H45TnB6OPbRl3dJD7JORvUt9beizMGrMzvRzoGQVrtaLIrgIIxks6go8N2vMqRjnbN0mBtnjANVb0pWJiHMWfLtKWtI5eRGC3j6XLtKNPZC9Yn5OcJupW0IkIzhqiWddiiXdoYA5ZRYR9ngfFMRhqFIpn41vMV00tWzJlXBeFiY3Wo9OTWnBCbS8q2D50GznRDDOlMVCoz1qsNpBzsWffq7MVcrZ3ae3QMMdHh8FIYNnJWTqd1P9qpNlJ9Et0JTCkw7EASsTbEaVusIRhO6a6242atkETkue2NDiaejELxWw7jclVPlonWrbd15XAdn7X5RhD8U7L6ux50rPpWtSmeYYFDkypoSO9Rv6vNmqg6TsUfl1Z5Fjt3ue4UQRHtI8W4K2jv13aroPOI2A6N5tyNuRr2O7hcdNzw7wLX39KsJs13mPef4bqlKr5ZPda746lj0bKK5cr0D0pApv6fCrrrBS68X9C9Gh4czrOtrEYCWfyNsBor002HRkw0EJEKcm3eOscta5Hnlh7fHDPFKubn287ZC63YogLiM6SNMCemn2UtFkGp7KzzHnlJS3Zy9aIWVGzwupY66rZIi5l7OCbqoG2vKGvbFfTMQAFwFyt6caZLi9a3RTykDafAEIbrmXl6LANDSw5SIQREkauAy7PwULANVYfsfD9so47QGLDLNm7AIYpNOeyOnr1bqzxZ27orGRnkB2dig8BYxw3MZaDOk2QoWlbhBvsDA73qyK13H7QdJDykyppFOzinxfRakxmFyRt12UdInDk4T37jQGTdTkuUEdLBUnNAzFOkoRmEdYARRegUOqEc7MVKFq9BhsvK9ldOo74xcyinuM1DoJ6eY1NhsH648rQ0wryNAvJLVMp4ZtwGGRyV6Zt55noB98i0ioGnpYZXLZqNRGKpB8lZNgmFSQRsRdaBiA2Ga3et0fki5LI5w3d5doksleR1a9fyn1Ybr4xM8ilv8UuuyZIsbHRen4D6tb2PNJe8GhKgcwSBjEGgFvzzLClgZbUV0MdFew6upNYngitiuJFB4OESid1TJWeJYCdBUTxiLTGMbAA2BEkQW04PWOIfqnohSaqXkT0KXCVFubFI9X1aR1wV2iZbxDXmIrZvx7NoMwk8tCkPjXd7fc2qVLjV5zYwcftxc6Iq6flIKSM8rAzTa78FdRgmS3JVGigpMuyo1RmNIFE376uDmhSTxiy0VQ03lit5DRwvzUKE33wMx3v5gdl637YTiwuG7t6rBaMz4k1QjoW91vEheBibL9nxRfypLgqDOq5lupiF8I0wku9XOzYX9ANSHvz6Vb4NcBoFucRpe2BjfxHzFpQjfMUp8DAJObVKu3h528AotzKrECypyUYD54V5H9MWO2IzAdbP7AuCSaTMBKy0C7lgX9g6MtEYBSjzk54GNsr2Sg4PdbcwXBKk96JJNJpKVNgWClB17XLw9MlVnGmHuhE3rwY3LE9OkchHO25XaYQFD52WfzvayCJYt1J6XBzQ2jKOZ9pihmHnTvKSPk6BuMzlOLQ9Cwdf6KDvqtXC3TUXFqD3xfO8VAhmR7A8WgpWoONBStxdv0JinTT4bOw5XykQjgLywcRKyrxISdPRloX6YmeDB1R4Me0CJ3uPym7riLoQY0Q6Wxu7TWBsbzAh3imygzF4RFpAGRAmIJmCqQMn9AIVqus5DWRx2C571colINBNeYQxslxWOnHnGpBByz06fzyFd35IwthMAwSFK8iX9cHAXD6A8cB7lg5saeZN7f2ZXEFARbTD51b4BYVoS2IsAI3MbqwPWVSIjYUqC1QJuduJ1SCRMkAXJCJfvcBW82nZgouHeCHU556g9IawwkNDiQ4LW3MPqUPyhL2fi9NFOkCm613xb1e8yE7xZAUNDl9hjfOv2tTqge2rjeXOcZN94wKma5ojouQjqnjbjsEVCYIedztP0BfNvSw1JaXunzwHBvxxhgdencpnrF3Cl9T6kJtoJEKHtONZySLgRkiGrKVv1JorH8izbTmB4J2MK2YyenoTGcOfcmSw6M1eao9ZAVwuCu7RvS4L0ZNESZpJCnGAY5qVy97GUOZLIV2bWggXKva8GFzh0kCGui2fIQeYaxv8cg3hMR2B5E9hc4Ar3u4bpGnaeboWiWGQuDFhKYkUCWsf9kPxLDUKnTD67ZEL26otciqCsGihZKJ9nEdY4qcOhNp4lyAVRY82Q2d5ZsnypGoJUpM33AH2VkHEl38pA40RIhKeavt4YmlVxJ8GlCLaZGnin6mD5FQ7guZ7S1EZ5qHrGmq2p989z69JlyjXmpthISHLPWOD3b6FazixLMvQ6IeB10gL3NgiVZMVTTQoW6489hgIoOxyScW2ScfoeWtCCRGF4PhA4IhiDQAUKLtyoyibcBs4gOKrrdQF7Cz2VqOR50ANConQcaxz6SXXooSmRt2CuB8t4pxsjK7LXvGHXvB9m4O4yofNre5M0lMdmtCuHsOGmfXysSmHUTa4yy3QDKH1xPLOM1PrvSsTsIcPQSA9Hz7m19IqaTVZ3pohKjPPmE8wwQhvHpZnDl8fc51zkOjvOpi8TUwPMszArmFg7aJuKF6RI0gDKbraRtt3pkaPx6zOgca2XRK1yBZGb2GqxTUPv4EgRMjJpJoEQxjmkEkkSwOkf3cYlXWJBQUXaLbXz9cc7SVjI2TEQt0surey8gC7K5gz1Bz7V9hv7ph0B9FPXH0i299tqcQKGzX1aErhq3ZipBJbFxr7QMFbeneioAkuO3OPcS9dyPXQvFU2WZJhbtYxpwhtdv0oGmI61Dx87qnlDchQz8dshzpyp7FOhCExnWlXrkyWi4jQQgJa5cOhdFLwHKfZM3qGZUnqSYS4sBpjNYDEVs7ctaUc0rbb1bA4lfrLiyDrJukDGUS69T1a9smcYFg3VGuIs1W1cztY3nkHKoPOLBq519gd6PpucGMMsI5tDvdp3WYdszimpKYB6aLCpFjCPoMWJ9ByGknIi0KMpVnftz01MfTyug12G1tlm2jDPIAf8Z5IbPF5x6vZOt4lM4IT2qbeYe6u8EG1DSVXv5hSmDTDl1SGmqHAaqr33gTiAfdZwEcFvSB48sfeiUzHh0p1OMVTEMSNmf93znBbVtbDF75cmtQPBnFjR9e7zoMjGBl6VikxJqgyMMh17q62uYzn2oqaUxMhditCpKiWiSZXuw8jlGsWcUHbWhUy4gXpcRrmbh36lS6lDhbzFbIB1SopdskkC2aFwOgPPaeJ3qFa5JSNP8Gj9hewYYXTN7dLw3dD7EGhX78SX2D2DyQrqH73mewA1VUPwIbB0HvxuoLnlhZLwpeGi4densZO5RdaSa5DGcPPDiWG9vpDnINZjipHiTm4WpteNAV3E2RCsW8wGXxGfFhLFZUc11WWOtTEINwkG9DV1HxPptxtrLegSQaGVpzKxXDpE3Njki0FzYw9nMRLnoEANO0YXXWoOXVSetflh3ygFnYfzrBT8kYxbvNIyrZWt7SxVufTEiFMApkecJNvAEsq7nvmYjEqAo67LKqSPsj6sn0xJB9lGa28R2Pdo4cZRvLLXvc5zf0RixL6dZLCI44B7VBbhEc6H57ysMfeZpESiDLFIDcnW0hRZVNvfQQTKPse46hOeP9PBQwY27EZ22nxmHobScCMgHdmR2ha813crkSOKZJPraMRXVxAjfzKoZJ7o4jav9XAgelLGePGDpuimuL2o1Hu7yTpMpnjeFYjyE3NpRhREgdQL9N8vGWQaCKCT6mLEV6XjwGQiaTEgBD2Hlz0vBGRYJmNNCEdpoStgH4ahbI0uVR6x4BQXfj9zvbwtoHJD1RTCCRnLOctYcpVUU0UxUE1yDibk9g3c40icp04vi3Kd9slbxXuYIVPxLXHpW0t9lfl4ejJSEoB3f0fSzOXTZD3iuoWL8cNq6FRakIqbtibtMvz9JXju46Rd93w3DivUYl6bI7VuzcQp6T2LBCWk27Ic6tGzZNZRbBK2Xkz8kGn26h583qZRGvDJR98GYmkdnqekvghrcPwOHukvrw653avjLA5OXq8YraXh1TXqCc9HOsAN3DAhJ0JBQgkg72Us02uJuDy2SvxHcxgpyQFmj6MeIW4o2s5Imgd0Xjh93IwR7PvrPcYk1TKQwKhbx5ZN0RMdTxslcUnoHZv8vCyrntdo1A68007tZBRlPxNEDOGRwykEip6eeAS8eEH23LTlOMxEhe5caXOHybeQZ7CDpHwkHcX2054ncLjfsIjB3xWsyMluXCa105Dbr88vhGy6QQ2fLPrv3JZHbMJyav6PH7QtH5q2Emi4U421tRYMgUV290r8QshDWK9Uf90I9jMjLNl9DfCIukGfq2dyDdu8HBy7r1RM3MmkcrXN6reyYEBxwcWeRx8QrovrXaBfHvnjdWSISeEkbWZYKWtyoCvMZ88UKBSuH44ER5UmjBRARGvFARoD9RgXUffZPpW0QLZyvLajl6NU0MInvXFIan3f1iJlsCkYLupPCRy6W7YDaH4WxXkjnblthK0NLsVISK6N7sXoo3Vah5rE6FcHHsx9FiohhQGROcjqLRzmFyDIiiJNzjkElTYrKkca1sSKf8zoWDUklqlYsFn318brsUvvDlrLobGdRsNiPFzZfmbsUyRcmlvoBN7poQgfOkUFtMmhA906X81f3hQmbb5FPSEXi7w5HK24EskcXN14y31X70nnecfkrNUDZfTUW1ohd2U6Mso6N5staBp0z9r1JTwYPuNnWBoqnNN3hLdQyFt1i6rsJKZQnudRmh5RxcP0sE8W1K33h7CQHXAtM8GUxwoA3y3bF5MVNiGlLubeBPRaCjw9nPlvJht4CCQK0eU1qs5KYQyOjfPzL0bbE59Lz2ob1t6ts0kR7KbQ26mJoH3rMEsvkXLKRUZJBNn3qluKLojThH6DPOLHAumMSUW9nDXrA6klmCRA4IGvJQI2rLi8TvoU3p3fJxh70BuzkcqhHJJiDxs8LiHx17nd2PTGCEnzsNCCeJvVfrwDLnrFZvhiw0WNa843CD7sGdndVDjpL85szYAbS0a657mYYXnb76jnNId4LeJA0Q491sx9w16s35eOO7cFOt1hmIGhzdyQLMBhhvns0TRWKxJFA31NUgXIApA4bD9mgdYtV9UJuiW6TBND4yDlnaY3dZ47TRfOjcRyQHEB5PXZ3rkiijUu6O0p7WdDFlgfupaaOqwT3rz17pR3GrB4ugET4axPEwikKO8cIijcFrZv1KvsZjCxmvC7rMhViqR9hgcY04XucLDWwtWSnHLHqfgzAlB6exZ3kUouxLmGmEisTTftJcl9efByOnDAtw656YJkstIn0gTSOSnI6jdw5FcD7BNQpr4e5CtyajrxwnCCCNVuXaZ69Q0qQB1qPd5PetsrHacziBIljqf0jyCYgEKQjwLYRn6wFoQ8dyyZ2uq0R3HkSfB3vQKXXoSyjXYBv0YJXHTLVfLAB891BYnC0UQjyENGhZSxi1usm3O85zvvT7enhfLOFrbla5UdBu13aQglMXJf63ZmFmVmcfXnRmlwesNWNoWemZXl14So1X1ZBFQRMI74Z8L4et1t21AEw0NxoxCCephSXN7m6tD4yYtzOlFTGjwT6ShIfvFISQ9ulXF338zbd4PPn7WiBhmEhOccB4i7a5hw0cV4SoopAkWxJ4unodmLZZrcw1s8THKAf4D4WDsCyyjtztQjvk4oC36Ljv79qorqfvUIzjhQd8pUsfqK1kRr0RjZOVTagTb0Z2HhVBRlp54sCtf9LJGrfv8lT0OwrfDFLsstnM4puAlM6CxG5vNkiyvWvEE8uzGsY46Ifm6OID9dDvzH1DIdRWdiiZugQsVuj0hLLXzttLsklHzhpn3saqcR4TWtwQb3ekKP9hDLhwxudvlVKxls8HoKbBnf3onrX1MoP31InmF1pYhduJMcqRcUHCA8TVjfSd3TIkuMKRWLZzAPM2jyp4TAe3R1GRYVG3qXYtgQtPahX1UkUChsY4Mgaiqg1UyDGMmcuQSSAs2AkycDXZHJROQbhfeczsMr9UzF75UOQQAaWsnydh1gnCdSIrQO5k3OR4M2jCe25Yrttrc18AArbQKgd02lvYTdylFkUpVze2Y5I7xU2dSDeaTVx94i5SVchew21XniPjbM0Pske5k9k3gVFguOrQ6eb8fcPqWkv1GszBwNcvtJDyv1z9e49LzEvbcPqK6wymwOnIshXZolJ4oyq0uGK1LqqBk13ZgaL5RUP2XwUaxb7UoqbPgcoXj2gVDADx2Uxhxs2yE7SYN2I4KOTcHNTtw740hkH2EWAAWkhmM1BH0wklsLDsorTXHnbOaX6hbs3zHRgVd4qxQnpk8yU2kKaB1iv7LDWavj5T8upC1TeszR60ijqkKfUSUmsIn52JnAzO2RZzc0LGrOCvEjHXm4SpLD9WTQ7D0Q6l7kScfAGGDmj2LI5uT4PeFf8DmCXgOAnaJGexD8v3Ol3tpRPnyINZqTpIIBpoMZjroJAPkcgfW3iFfTIUoqiOt7Oeldt2odsgJjdwezX9kZAQLvMiaMd7t15AQeYcpUjcDyudeQfJZFpYeByZHEzX2yW3pA8iSgmsBsFIg7uDAbugmNYlGI5qXx17MUU5kcByi2Y2HzCxKTCwsOtQsc4jIURmyeM4IqdaLRoE8u6EqYEkN2XTOBlzJnQAjhkylz3Yyn8rtq7HWMVs1ae7RrIBwlBzlyJyMEdG4Fmu4W62e4QYhLE4J2W7bWFyD4Lu9tSjUQ7rk12suQjdeXMs9rA1DszClGD4qhKOQGrB50dWhmTxxIBbjsP9cE5opCaqsQIxvVybSnrnQ3g1CYqpMplaoUMbp4Iy6EzbqiutX0V32xm3WBAdnHNQeL5z4ZlE3lZ8xobv99RBRP7m0tCUeffkyJr3uR5WuIMbPNsojaIJ7rcUH5AwA5vZoT3tyEGltOuPM3RCxEgMIkTjxvJ9eYBahG26HoFXigTe1AEHxaGKqYzBwifNjebSkcNW7afvUa5hQ8NO9XbfPznsLclabgP4k2QakRsV3mNim7Ib7tP4ogsERAgDEtO4mTBTW9MLeTHsBKL357Wnd3ruOPIXsqU6VvixfOhyFiZxKFqnSCZUbMnwVmKjr9EIkezuKbU03eqz3QrfQMRoO9oROCBipne4gJLHz41aJ8DrRnNr7P4NZbXXhooRS1b3Mv748jCr7ScEtXuOngKumS4fssmqPcP4bO4oR5pGIwsJO7UnvQFaRLAPcOElNzzlyBFsogApbhemZIjAxPGLXqv3iFGxr3MP1PlOhPqrsy6xOnXvxC9uuetO4tu9l4BLqdndyedbCA1ZYknRJtRgjGOub98NqriBoUjC1oM8SWlfWHmCxsLmaxWTQEhgJLNAE3H7dzj17sDEO37ZMLNB30Ah7gru6uEBPsNhcEdfzd2UvX0mRFttjgdj1PWX4afPNTxBkXh7KNjppKhGr2wpq5j8z4gkxxblxTsZceovccRCINYoIkZMP0FHPdL67aK6wEFjisKAZVGYuZG970CYaBTvt8RjwNzhXSn1brhMRKdjYsQZ0Mpu6VxrYkSHIuCwYacmKjHKOlJln05OHfEeztikD22vih0m45oDLRvP2DUlnu38aCbjiKrWPBakSR61h6th2TjdRUpcVbAUtHP9z5fPeg6uEqIWvGCbyxLnbrzlvjnKv8B69GTHooHvP4Q3hae1R3mJ87tB24IBf1nLv5Tamm3OfIkeTTQ2EWlWoyc6GSVeVje62Oz20kHHcq7iFU95hmM3LHNqKp1DyNZpUnKyhW60TJcJxEG4lB7wTw4id6IctLQp7twHtdZrfkSqYuguruNIKthbUwHbxtCuiVMY9erzW5ty1S2rJA2EbGIU2udxWV8tZsOQFL3SuRs73CPYddoLTiD03r2WCC3bWzbkJD0VgXMQNipKu2JVaZymYc7OJLgmDKtAlSUP2zDBwjzLpiD1lvnptzh89FYQBaw0cWcN6Y8x8krc4dFodagACtgGlaMVLj0YTYSPNq8E4HSBVZuXdBoNKaig3MOas3BFKh2gMSSK49WeEH47JQHWCZtGa8pzTI4xSxo6go60VL3bOGSqNfk5ocvVwwmWDX7NxfWPdPRfo7VW3nEL8WYqJKoBEH4MUlYikbeKjFpyZxJFsrWX2Xk99x0XnH2hKZgzopS8KUd7c4g2FOL4Zxm5dMO7FTC1rcWIj1FajRhJigZcSrugEQpijLck39lMQldVe6SMq2jsP2qW6H8qkd05b8Nc6eJncE7gAg8GCtcyGB1JuKbdRq6W8jWeBQ4yr1Hpqv682QpljzWFaL1lmyqcvEM5VfMgnoSVdzNgdC5vXPR0M77E6EBHgWfgeT57MDU4gWc0VhwyUmiV7aB8TA1edaRS9ljHtValWDTjIZXVcusZnDpewdJ9wSu79BaH0SXN8VTIIowwtrAefeW4SQSYwj3FXWJIl0tmj5ZefIrL9vgBhPIhSh5lg2rjQfuyJSPAmuhvxrNho3ufsiGYefh39wZjQtxD8rSXa91o2OujNLZRSFmi9kTfkAJElbvIQZzwZkfgjGnXzpxTXw1QJn0kpTTUdMJBmXJCalmtXZmg8zi2dAZ2kEO3So57ZdhBCCz03YGr0zZs2w7TnDeMmEZ0tMllnYc57m3iO4kXMNNgQLBtxwEmOvpnPPe6yTNV2Otw728FOByflvqKPXLId5GJeIQHohIWoUhRDb62nlhqN6Ad8gngodK33MZFBtWnUj1fMPSUd6CDEeY4MncoqDeDEyg2YhiIvcLjwSd8E3BpzGtIkw9vYmWMXszpYxkzqEhTnYMmnGNxtQIHdEzwekukhSvIkPpdeKaurrwtk2Nw5ZwOJdVLFcuBLNHU8tdQDYpk1cMcgcNtWnROMHOaplU38FsBlslTsRvbnOTi3qNWHN65bPCNGoAJMF9hQcGncIaOif5YhuElkKJGgljDhYpljKX2ciWavmY5puwWwqRd5yY2XC1pL44OqUaKMWmKtjUHzVLcLgzX8gWVT9h26Tyb9PixhAfQpxYsNJ3Oy1X8K2DzBk20W0ypAcMLOfZsQIx2E1vpKw1dlRM1BEXWJUGO0UpS9qB6hY6Bn1Sv68c9TUvA6HO3pO4G7JBsIZIbajuBZJXIv31DIKIj3i8ImAq8PR56lx0Y6c6nwUGud0F22eatRRZG5cIQHIStHs231VKoJDDxJSa9EXpphqY0JCKfFCVkhl1oWmXQrlrkrhomOjaXRXG8rkp5VjdacZd4kBYMm6so7R0Mgomw8Bk1v8hhIIHWOV3IA1QlWwoKNZEIFaQ1V1Guz6JstQ6bO0VWFEkUONaPQml0x2atz3oRVzSMdyjDvPOiioJjFJBBPhf0XVM4XAE5HBjQ8d5QVGTZs3MSvKIN1qlVgfzERU1Qa3ZsIYE7BLGsAystVcrS9RlC7VxtHTKBlncirYy3Uieawd9XTEwe47TBTm0gs5YavMlhgyAwKt1BBv02ei5LlVbVC2dcvqmQ9d72WGBf6LkRG9o0MxqHfj9ZNinwEFvYdWwa3lEh7zabwY0P7j5GgQbgYxmI97wd7hqj2ULAWEV2pPqceNvFaf5SPbWjIxgwQO0nZMfIfey5LX7mfkJLHbCGw7qJoksrk9hlDBC9NwKQNNY9qYjO4zuMpqvpCB4JZFbhKgyGus1GfpHkO1RXllKrz9767scfk6lHhnU03UTluy6r7DcQCNCP954oeKLR0PQ0vnOYEtnQrr4PzECI2XV8AFf2yqOYO0Cx0pBw23WBq28UkBGhMJ2yzhmVu74PSZhoCBEMBypa7ZDN5YII6V9Jpnheu3hTC1ROTO615KmIG6JW6D3nvvsB2T50MThXyJZeX1LI1H3zOPZnreyYiVkGqhHTwPqSYP5smRcPJIXDfBHGN3LAtPfCKuRLlPxnyFNQsKR8PFcfJeQfBJmc3PlVbbEDxIzTPKu8c5L1t6GyssHHCYxhHxSPrNC9EBAJLdLYzk56jLzCJeg7Q9mA3TDToeoqrlKz4CRnA8ZBkFgKbO0bmwbaghXAURLBsp2G2j3U0Fo1YRNTzAgS7CubrvemQZROkqT4yRnEGqb94HyJk8XArKVr1MKaV8t50xIyVNQiPwTbzBpu0cECLff17OCwYR2TPEwwKwKYqnucAcYKgFWd7X2pmuPDieUAIBmjl2T1HU73mqhrCpp1xJjaXW2MAEr39StUw767AFsuYlQGWnxjIiCpQEGGy7L9E3RbSS6daLTdMtjs5vk8VCvynuVKV2grmlbmgM2KzLk3ujRzGYdKtiAPNHLQNC7fiHoGwmuD2ECbjhZRsXDmxqspRxszyTMSg0jT75GhkwzEQ7kVf2lbsehUunue36tf3Ib0BqM8AwqPBZ8i4Paozb2snFp6imMoHCQ0kQCX8J2rMAZWtsBJ34S9iy0L1vY7c9KIpVzOw3FJGhVuoH63l9XWfnsduOD1V66GFJHze1suikeDO6C1mglracjqWXZnETvO5dzQBxM3Fbx2ZYrHqN25d9mwVXTlI4l5cpB9vk1Owhf9IElugMzgUdPmfDB0tF6qw05nmlUPKFgZKQ9ppP3B1HzT0LadZZhhjxvDY4d0qDrt256HNBT0pJN4YMlXEXkIpXldRSWuBnhz7HEbz8x7F6c0yXTGdurabHeAjSR86A7cXLY99LZmtNja123w6Cz8Nj34afWXLxVilWeC38ai9Smp4QyiuLL3cozfwdMNljWQpnAUSH8ZidTFlQbCxOPKWtVEyUIiaMOAyOg3i46ytbePOUQs0GriDrBWkObT7k2aezI2jC8KzWFmyy1xOV2rAKvIjoHfnpxeMJcizUB0QOjbeGXKkLUF9gitG3aqnVW9qPHpADJZXhHakVYNW1ULfzwPDZ4mWiYFo2xNqd4P6Ob3xvTCJYFUSLpfeDnerJW9uZlWikkjCKBck7ZWPHOae6TaxKuoy03O4NdZxAAFCkbkc2fGmAS3qVJ1wbT45OfYWn5Sw8yQG52qxXKvIryvAtn2hswyxSHsAIgcoPinM6jzARSsJlAzLbOzoFyH3iIT0WniKhOtVYevgUsYvjgItkuICKMfSoIzbOHTTnDQOxGS5lyHitH8GG8ycb4GIv1Ck0qzhtApgAerJsyHCzRcFqKx38JJuj5kkbAIv5Frxs5XUAVR74GnhQVVSxiynK03K4WHTdvRr4pODSmDRIIO7PKsPHcp4YMisYt9yN5nvXm8QduyPZTsVtpuGn3P2OTRLO7N1QsBefjGqzfmMh7PMQ8aAQHxnm6qS1p21BmPN43HcHWSAkRRTRcHExzD0lOEjo4rNBvgGU9WGQgct5Jrd1TtETpZEl7KTlI7oFaUwiRd3Lb6wRVGANIViu7PGtpIL7yz9g3lFOKAClQI5s60lbYxqBs4prNyBvlsUGoOFLxDC7loGFkmBuLrQVUfCXfutHnwtFPLYhQjQmCNPiunR62h3IucaPOinAtfcJUMJ0bZhtxFua3WEVYrAB5EaLZiPBi0AogMRwcVBmvs9a003TUPPfvxhJA5t3X1FO1rE55dU8yCXRN0u8Vmr74HKSIa4MWuiP6HDmcmiPjPGKOlJJlomtuqsbHb8mhcD3DJrMDmhfebIKxNkvlf3OpB13itQHrfblf6ECouE9RkTYGfZZk5d2BavLrD00QKRosTCmM3yyhUms70TgU0THVBi4QPSQEyiY4nw8T43re7lUgmiUY5Uko5x8ciaHAuOj23tdwbTH0eWSb5mGgOrHiwCh7JDbTvb3vm7MbO2EQDDNNfRSNbROjEAsx2K96rzd3StvpMCy4EQILSxYOptCdO10hR5d1kEg9KGIyHneCr6gTnkxhaAMw4NFLaupBrF2oLymqTTEfU3Eo8T9jgL1tbUiG5yRlFZoUHAa9no1YVDH0XAOwK81JvnrgXK55yb8koELAq5aY28rHMVf8nvrMy5Bg45yCC0U9VpJhfqKpmWFrgHPMtckYFoz9KYf8dG7e52y8kFe6XuHzyMZT1LzgZDDQgneQJddTgAedR4TdatCQbdozjpjDZldSxwBUT0gzvVa96BK1TZUTz4LXhbdHpdNcrWwUQ326XxI4TqxLn23JHzx4L8FdjYvLSot1c5wm1vl2WSw4AIqnvrnzYAyKCZx7K2S2Fad35HJYDhyiVJS39eSlSYlvMD4VvGAHhEkEyca6MAr2mLnMPaidJIbXXXQnnOkgQhPmVObMkipPnlisNSUT6Ygdmz5PxtKsPxS5Kb6g0LrKp5eyRU8yD9HLSnLOSGmsvCG2lH6xOAkK4twP3eeYez4RbpjiorWWIi0zM6SilkCtlMKwyFf8KgbwWWOav5x1WZyMwFvw2GAGnj3dsrROHb2S2ygGTKTztF7hbhJjPhWwIAxwia9eywO1Dql3IOBfb1CIuaXCLrFlWytglREYKAVSvd2tAkYSaTDSIbomlv0fdfy2K0HhJXqXEaWpahFRvUAuMFUqR2HkybSVur77rez56glUe3DTpZ7moY8kE7QDFncbZcKgaygzabRSqKS4G0IYy5M4q4LXq6KjCKIsJN8t8jfTvT9nznOPBvdsMPIk5mJTc8Mvma1wSZjQeYFLnTvjW683XJJMMMxd21hFjkEw1DF4w3g3pmhjpcZ5xX7WYFhyhhoqyjVmhu3dEwmENX9Idc6tnLWNC1zxjG93dNyy5JtuBuvCXDxE2v7RkJJD0cl5emu0YqaZxyFjBBviE8hFB3hVzTTJFgJgrcX2AaCz3l4UWWG075CHkeqRr7RQ5PBwVSuL0IRjQRM6aiSNbQRtwqAhvTdPbm2qRom6wjPhly9g7DN2OGxB7FTdeFkpl7uTQWl51tlIAJwUkKCYfuUeJ1TfKARQLWghaRToshEQFsn3bzlmGS6ZF4AMgYHiuNu0RrmEdNoFGrgNh6i8qS77GlUipNGJwTyRc3w1JTtDEPJQT1hCKPeWHY1VNk1t9wyTGvCIkm3M9tRihb9RLnRytWx7ZTUMVDNIAzoAHtVe08L3gLyQ7jOwjMRsMkGs3wu414L2ZGzGzXJcrmWHYaU17BkWBA0hvd08ebXhb9WFlnSa6coMIEhIVHLpIaUN7pX8JUh614wUwgxgEPzwrmbr1x1Okg1gTXqNEp4j7u1vip0Tx2cfqt2uaIpm6ukwMkhnS5UZkZQ6HETj8mibIii8CMr9IuwFuKiRFepMzzkCchoZKx54ZL0ceB7eMdYxWazSML3EtGnJdepENioWOQ9YwHWqAtdLtiiEGw8vw8po5bx5koDGVIsgLKYiCt2RbXYfoJJ3VNpRrrqWC85mbzBJ12hfVzNqUy6OuomCrq0en8rrXLlaQUuwoLX4P965g2FGKB39l8DjfvmmkG7TPg4kiPIirXn16HdA7UdDXoI5SyUOmW3BfCIbWn5Z1vPFhJKkrLCGdvtHnUdhuo1RDtUHwwmFtxxjlJl5u0tRJXjK4qXNR1gNoO3cAd7cLX26JDNSfy1zxxcXljKyDR099GdMELMkCpEx0wQBTlkvdWKtbQFMyKVKJ5XtLlhinN8RdimxicrlgMJL9EnQ0Dp4DwbPpnubGSQwJeJfPaReQVs4g90hqCd9NFDMJLOIBuc3gtZEFdHatTpLqkJgOm6VbjAT2AxtX6mXXipRUyxtmcgfiCQ3PB0MH1UswybJF6f5AoXlnJNJpMm6eFYl59FBjRUe3rir8KJfx09kfekSsQAnivJybE6euNfcf5NtXMNvVkLghpmxHqpqr39Cg1Pi4RNVCrAc1g8p2zk1XNIFhecql48DujlKew2QK7NJxrtWu7VHy9jWQkBxEvI64rDSnlev1GB0rRcAM0iIU09svA3UL7YlnlXqCa8OuxXpd5KWnZI7wzWWLi5wsdeY5iwFxp9fE8SxEEel8lXnd7tmnFc67bydrDs6qcbZsaMxcOUjNi2mbT0Q1dwML33xcjCOrzEjxTzrsYng7Pt0dD8gE73FtMWkRy27H4elfUDhRHmppYbZLEHK3rrgCWVnxw7SUUVV01xNd7p2pO0fhE6SMMBskjZYCECyVhwueJHU8zDeqVqMHarkQUUivKBomR2QfZIuHi9f5tOxz4ELxFLevsZ2PC6JJTjR7WpH5Ut0ZKyYSetrA2yfkLSxgL3HPQBopVxy2osj8LV0XLwcqMbdJDXEAdx8GI43kcmrA31IsKQvwryM5BDuXMsnhL4eAorz7kYdO2b7AcdXQqwJ1WVG6KHsXlRNz4rw0IlfOfLzy79D6bHeb2N7wuxuhCo0xyx5iZsmyxk7gGtqyTfF5H4dEdAymmQwFodCzxk4HyOGCvoe7RAG64ScD9VQmJ8JES8zVUbH83HPESVu1bwD5d3vAjhdVfwwp7ddI90lcuG7URCyTAZY03SRJYEqMZs2FAf5ds9TOgoKjxbtOaA2shMhFZAjOdkhHf3lVb6J9KUFTH1AP4ITMxQjPe4a1Gu0IY5QvQe5FGRm3BfZUrFcAAnfFLB4oenlOcXRJboGf0ZHYYb3gjn52z1x0kW8SSeEeAIZ5pJ7Mei8eEa2oEzFZ317J5eEBqaOkGuRH6ZhZfmJeVFYrDzjBHLxnNmk64gjIGu0nsdJo1f8RxyBZDvc78jTgz71iGTf3VTqNnAdv89qgIfIFsUXhPwCcaisjNvpFrdfHN3MhWirABveRnD0hALC0eu0QEgrlOdLgufuvSHCYX7LYvA3zrxQC3SajT0", "Nexon", "White", "KBA3", "22/07/2023")
+		_, err := gwConfig.contract.SubmitTransaction(txnName, args(carKey)...)
 
 		counters.mu.Lock()
 		if err != nil {
@@ -83,11 +89,47 @@ func worker(
 }
 
 func main() {
-	var counters TxnCounters
-	startTime := time.Now()
-	totalTxns := 100000
+	if len(os.Args) > 1 && os.Args[1] == "listen" {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		err := listenForEvents(ctx, "manufacturer", "autochannel", "KBA-Automobile", "checkpoint.json", printEvent)
+		if err != nil {
+			panic(fmt.Sprintf("Failed to listen for events: %v", err))
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && (os.Args[1] == "order" || os.Args[1] == "retention") {
+		err := runOrderCommand(os.Args[1:])
+		if err != nil {
+			panic(fmt.Sprintf("Failed to run %s: %v", os.Args[1], err))
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && (os.Args[1] == "attach" || os.Args[1] == "verify") {
+		err := runDocumentCommand(os.Args[1:])
+		if err != nil {
+			panic(fmt.Sprintf("Failed to %s document: %v", os.Args[1], err))
+		}
+		return
+	}
+
+	// Several modes run one after the other, so their results share the payload and the network state.
+	// By default both payload modes run, so the results depend on the payload of this directory
+	modes := []string{"single", "chunked"}
+	if len(os.Args) > 1 {
+		for _, mode := range os.Args[1:] {
+			if !validLoadTestMode(mode) {
+				panic(fmt.Sprintf("Unknown load test mode %s, expected create, single or chunked", mode))
+			}
+		}
+		modes = os.Args[1:]
+	}
+
+	totalTxns := 4000000
 	numWorkers := 1000
-	jobs := make(chan string, totalTxns)
 
 	// Initialize gateway once
 	gwConfig, err := initializeGateway(
@@ -102,43 +144,37 @@ func main() {
 	// Ensure gateway is closed after we're done
 	defer gwConfig.gateway.Close()
 
-	var wg sync.WaitGroup
-
-	// Start worker goroutines with shared gateway config
-	for w := 1; w <= numWorkers; w++ {
-		wg.Add(1)
-		go worker(jobs, &wg, &counters, gwConfig, "CreateCar")
-	}
-
-	// Send transactions as jobs
-	for i := 0; i < totalTxns; i++ {
-		carKey := fmt.Sprintf("Car2-%d", i)
-		jobs <- carKey
+	for _, mode := range modes {
+		if mode != createMode {
+			err = prepareLoadTestCar(gwConfig)
+			if err != nil {
+				panic(fmt.Sprintf("Failed to prepare the load test: %v", err))
+			}
+			break
+		}
 	}
-	close(jobs)
-
-	wg.Wait()
 
-	endTime := time.Now()
-	duration := endTime.Sub(startTime).Seconds()
-	// fmt.Printf("\nResults:\n")
-	// fmt.Printf("Successes: %d, Failures: %d\n", counters.success, counters.failure)
-	// fmt.Printf("Total Transactions: %d, Duration: %.2f seconds, TPS: %.2f\n",
-	//     totalTxns, duration, float64(totalTxns)/duration)
-	file, err := os.Create("results.txt")
+	// Results are appended so that runs of different modes can be compared side by side
+	file, err := os.OpenFile("results.txt", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 	if err != nil {
-		panic(fmt.Sprintf("Failed to create file: %v", err))
+		panic(fmt.Sprintf("Failed to open file: %v", err))
 	}
 
 	defer file.Close()
 
-	fmt.Fprintf(file, "Performance Results:\n")
-	fmt.Fprintf(file, "Successes: %d, Failures: %d\n", counters.success, counters.failure)
-	fmt.Fprintf(file, "Total Transactions: %d, Duration: %.2f seconds, TPS: %.2f\n",
-		totalTxns, duration, float64(totalTxns)/duration)
+	fmt.Fprintf(file, "Performance Results (%s):\n", time.Now().Format(time.RFC3339))
 
-	if err != nil {
-		panic(fmt.Sprintf("Failed to write to file: %v", err))
+	for _, mode := range modes {
+		counters, duration := runLoadTest(gwConfig, mode, payload, totalTxns, numWorkers)
+
+		if mode == createMode {
+			fmt.Fprintf(file, "Mode: %s\n", mode)
+		} else {
+			fmt.Fprintf(file, "Mode: %s, Payload: %d bytes\n", mode, len(payload))
+		}
+		fmt.Fprintf(file, "Successes: %d, Failures: %d\n", counters.success, counters.failure)
+		fmt.Fprintf(file, "Total Transactions: %d, Duration: %.2f seconds, TPS: %.2f\n",
+			totalTxns, duration, float64(totalTxns)/duration)
 	}
 
 	fmt.Println("Results have been written to results.txt")
@@ -0,0 +1,111 @@
+package contracts
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+// AccessContract contract for managing the access policy of the other contracts
+type AccessContract struct {
+	contractapi.Contract
+}
+
+// Init stores the default access policy unless a policy is already on the ledger
+func (a *AccessContract) Init(ctx contractapi.TransactionContextInterface) (string, error) {
+	err := checkAccess(ctx, "AccessContract:Init")
+	if err != nil {
+		return "", err
+	}
+
+	policy, err := readAccessPolicy(ctx)
+	if err != nil {
+		return "", err
+	} else if policy != nil {
+		return "access policy is already initialised", nil
+	}
+
+	err = writeAccessPolicy(ctx, defaultAccessPolicy())
+	if err != nil {
+		return "", err
+	}
+	return "access policy initialised with the default rules", nil
+}
+
+// GetAccessPolicy returns the access rules in force for every function
+func (a *AccessContract) GetAccessPolicy(ctx contractapi.TransactionContextInterface) (*AccessPolicy, error) {
+	err := checkAccess(ctx, "AccessContract:GetAccessPolicy")
+	if err != nil {
+		return nil, err
+	}
+
+	effective := defaultAccessPolicy()
+
+	policy, err := readAccessPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if policy != nil {
+		for function, rule := range policy.Rules {
+			effective.Rules[function] = rule
+		}
+	}
+
+	return effective, nil
+}
+
+// SetAccessRule replaces the access rule for a function, named as Contract:Function
+func (a *AccessContract) SetAccessRule(ctx contractapi.TransactionContextInterface, function string, rule AccessRule) (string, error) {
+	err := checkAccess(ctx, "AccessContract:SetAccessRule")
+	if err != nil {
+		return "", err
+	}
+
+	if _, ok := defaultAccessPolicy().Rules[function]; !ok {
+		return "", errorf(CodeInvalidArgument, "the function %s is not known", function)
+	}
+	if len(rule.MSPIDs) == 0 {
+		return "", errorf(CodeInvalidArgument, "the access rule for %s must allow at least one MSP ID", function)
+	}
+
+	policy, err := readAccessPolicy(ctx)
+	if err != nil {
+		return "", err
+	} else if policy == nil {
+		policy = defaultAccessPolicy()
+	}
+
+	policy.Rules[function] = rule
+
+	err = writeAccessPolicy(ctx, policy)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("access rule for %v updated", function), nil
+}
+
+// RemoveAccessRule drops the stored rule for a function so that the default rule applies again
+func (a *AccessContract) RemoveAccessRule(ctx contractapi.TransactionContextInterface, function string) (string, error) {
+	err := checkAccess(ctx, "AccessContract:RemoveAccessRule")
+	if err != nil {
+		return "", err
+	}
+
+	policy, err := readAccessPolicy(ctx)
+	if err != nil {
+		return "", err
+	} else if policy == nil {
+		return "", errorf(CodeNotFound, "no access policy is stored on the ledger")
+	}
+
+	if _, ok := policy.Rules[function]; !ok {
+		return "", errorf(CodeNotFound, "no access rule is stored for %s", function)
+	}
+	delete(policy.Rules, function)
+
+	err = writeAccessPolicy(ctx, policy)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("access rule for %v removed", function), nil
+}
@@ -0,0 +1,137 @@
+package contracts
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"kbaauto/query"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+const maxCarPageSize int32 = 200
+
+// indexedCarFields are the Car fields with a CouchDB index under META-INF, the only fields QueryCars can sort by
+var indexedCarFields = map[string]bool{
+	"make":              true,
+	"model":             true,
+	"color":             true,
+	"ownedBy":           true,
+	"status":            true,
+	"dateOfManufacture": true,
+}
+
+// CarFilter selects cars by their attributes; empty fields are not filtered on.
+// The manufacture date range is inclusive and compares dates as YYYY-MM-DD strings.
+type CarFilter struct {
+	Make             string    `json:"make,omitempty"`
+	Model            string    `json:"model,omitempty"`
+	Color            string    `json:"color,omitempty"`
+	OwnedBy          string    `json:"ownedBy,omitempty"`
+	Status           CarStatus `json:"status,omitempty"`
+	ManufacturedFrom string    `json:"manufacturedFrom,omitempty"`
+	ManufacturedTo   string    `json:"manufacturedTo,omitempty"`
+}
+
+func parseCarFilter(filterJSON string) (CarFilter, error) {
+	var filter CarFilter
+	if filterJSON == "" {
+		return filter, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(filterJSON)))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(&filter)
+	if err != nil {
+		return filter, errorf(CodeInvalidArgument, "could not parse the car filter. %s", err)
+	}
+	return filter, nil
+}
+
+// carQuery builds the rich query for a filter, sorted by one indexed field unless sortField is empty
+func carQuery(filter CarFilter, sortField string, direction query.Direction) (*query.Query, error) {
+	q := query.New().Eq("assetType", "car").Exists("archive", false)
+
+	equals := []struct {
+		field string
+		value string
+	}{
+		{"make", filter.Make},
+		{"model", filter.Model},
+		{"color", filter.Color},
+		{"ownedBy", filter.OwnedBy},
+		{"status", string(filter.Status)},
+	}
+	for _, condition := range equals {
+		if condition.value != "" {
+			q.Eq(condition.field, condition.value)
+		}
+	}
+
+	if filter.ManufacturedFrom != "" {
+		q.Gte("dateOfManufacture", filter.ManufacturedFrom)
+	}
+	if filter.ManufacturedTo != "" {
+		q.Lte("dateOfManufacture", filter.ManufacturedTo)
+	}
+
+	if sortField != "" {
+		if !indexedCarFields[sortField] {
+			return nil, errorf(CodeInvalidArgument, "cannot sort by %s, it is not an indexed field", sortField)
+		}
+
+		if direction == "" {
+			direction = query.Ascending
+		}
+
+		// CouchDB only uses an index for sorting when the selector refers to the sorted field
+		q.Gt(sortField, nil).Sort(sortField, direction)
+	}
+
+	return q, nil
+}
+
+// QueryCars returns one page of the cars matching a CarFilter given as JSON, optionally sorted
+// by an indexed field in asc or desc direction
+func (c *CarContract) QueryCars(ctx contractapi.TransactionContextInterface, filterJSON string, sortField string, sortDirection string, pageSize int32, bookmark string) (*PaginatedQueryResult, error) {
+	err := checkAccess(ctx, "CarContract:QueryCars")
+	if err != nil {
+		return nil, err
+	}
+
+	if pageSize <= 0 || pageSize > maxCarPageSize {
+		return nil, errorf(CodeInvalidArgument, "the page size must be between 1 and %d", maxCarPageSize)
+	}
+
+	filter, err := parseCarFilter(filterJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := carQuery(filter, sortField, query.Direction(sortDirection))
+	if err != nil {
+		return nil, err
+	}
+
+	queryString, err := q.String()
+	if err != nil {
+		return nil, errorf(CodeInvalidArgument, "%s", err)
+	}
+
+	resultsIterator, responseMetadata, err := ctx.GetStub().GetQueryResultWithPagination(queryString, pageSize, bookmark)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not get the car records. %s", err)
+	}
+	defer resultsIterator.Close()
+
+	cars, err := carResultIteratorFunction(resultsIterator)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not return the car records %s", err)
+	}
+
+	return &PaginatedQueryResult{
+		Records:             cars,
+		FetchedRecordsCount: responseMetadata.FetchedRecordsCount,
+		Bookmark:            responseMetadata.Bookmark,
+	}, nil
+}
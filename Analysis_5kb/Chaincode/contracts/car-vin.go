@@ -0,0 +1,149 @@
+package contracts
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"kbaauto/validate"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+const (
+	vinLength         = 17
+	firstVINModelYear = 1980
+)
+
+// vinValues transliterates VIN characters for the check digit; I, O and Q are not allowed in a VIN
+var vinValues = map[rune]int{
+	'0': 0, '1': 1, '2': 2, '3': 3, '4': 4, '5': 5, '6': 6, '7': 7, '8': 8, '9': 9,
+	'A': 1, 'B': 2, 'C': 3, 'D': 4, 'E': 5, 'F': 6, 'G': 7, 'H': 8,
+	'J': 1, 'K': 2, 'L': 3, 'M': 4, 'N': 5, 'P': 7, 'R': 9,
+	'S': 2, 'T': 3, 'U': 4, 'V': 5, 'W': 6, 'X': 7, 'Y': 8, 'Z': 9,
+}
+
+var vinWeights = [vinLength]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// modelYearCodes are the model year characters in order, repeating every 30 years from 1980
+const modelYearCodes = "ABCDEFGHJKLMNPRSTVWXY123456789"
+
+// VIN is a decoded vehicle identification number
+type VIN struct {
+	WMI       string
+	ModelYear int
+}
+
+func vinCheckDigit(vin string) byte {
+	sum := 0
+	for i, char := range vin {
+		sum += vinValues[char] * vinWeights[i]
+	}
+	if sum%11 == 10 {
+		return 'X'
+	}
+	return byte('0' + sum%11)
+}
+
+// DecodeVIN validates a VIN against ISO 3779 and its check digit and returns the manufacturer
+// identifier and model year. A letter in position 7 places the model year in 2010 to 2039.
+func DecodeVIN(vin string) (*VIN, error) {
+	if len(vin) != vinLength {
+		return nil, errorf(CodeInvalidArgument, "the car ID %s is not a valid VIN, it must have %d characters", vin, vinLength)
+	}
+
+	for _, char := range vin {
+		if _, ok := vinValues[char]; !ok {
+			return nil, errorf(CodeInvalidArgument, "the VIN %s contains the invalid character %c", vin, char)
+		}
+	}
+
+	expected := vinCheckDigit(vin)
+	if vin[8] != expected {
+		return nil, errorf(CodeInvalidArgument, "the VIN %s has check digit %c, expected %c", vin, vin[8], expected)
+	}
+
+	code := strings.IndexByte(modelYearCodes, vin[9])
+	if code < 0 {
+		return nil, errorf(CodeInvalidArgument, "the VIN %s has an invalid model year code %c", vin, vin[9])
+	}
+	modelYear := firstVINModelYear + code
+	if vin[6] >= 'A' && vin[6] <= 'Z' {
+		modelYear += len(modelYearCodes)
+	}
+
+	return &VIN{WMI: vin[:3], ModelYear: modelYear}, nil
+}
+
+// parseManufactureDate parses a YYYY-MM-DD date that is not before the first model year or after today
+func parseManufactureDate(value string, today time.Time) (time.Time, error) {
+	date, err := time.Parse(validate.DateLayout, value)
+	if err != nil {
+		return time.Time{}, errorf(CodeInvalidArgument, "invalid date of manufacture %q, expected YYYY-MM-DD", value)
+	}
+	if date.Year() < firstVINModelYear {
+		return time.Time{}, errorf(CodeInvalidArgument, "the date of manufacture %s is before %d", value, firstVINModelYear)
+	}
+	if date.After(today) {
+		return time.Time{}, errorf(CodeInvalidArgument, "the date of manufacture %s is in the future", value)
+	}
+	return date, nil
+}
+
+// checkCarIdentity validates the VIN and date of manufacture of a car when strict VIN mode is on,
+// and stores the decoded VIN fields on the car
+func checkCarIdentity(ctx contractapi.TransactionContextInterface, car *Car) error {
+	config, err := readConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if !config.StrictVIN {
+		return nil
+	}
+
+	vin, err := DecodeVIN(car.CarId)
+	if err != nil {
+		return err
+	}
+
+	now, err := txTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	date, err := parseManufactureDate(car.DateOfManufacture, now)
+	if err != nil {
+		return err
+	}
+
+	// A model year starts at most a year before the calendar year
+	if vin.ModelYear != date.Year() && vin.ModelYear != date.Year()+1 {
+		return errorf(CodeInvalidArgument, "the model year %d of VIN %s does not match the date of manufacture %s", vin.ModelYear, car.CarId, car.DateOfManufacture)
+	}
+
+	car.WMI = vin.WMI
+	car.ModelYear = vin.ModelYear
+	car.DateOfManufacture = date.Format(validate.DateLayout)
+	return nil
+}
+
+// SetStrictVIN turns strict VIN mode on or off. In strict mode car IDs must be valid VINs
+// and dates of manufacture must be real dates that match the model year.
+func (c *CarContract) SetStrictVIN(ctx contractapi.TransactionContextInterface, enabled bool) (string, error) {
+	err := checkAccess(ctx, "CarContract:SetStrictVIN")
+	if err != nil {
+		return "", err
+	}
+
+	config, err := readConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	config.StrictVIN = enabled
+	err = writeConfig(ctx, config)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("strict VIN mode set to %v", enabled), nil
+}
@@ -0,0 +1,161 @@
+package contracts
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+// MigrationResult reports one batch of a state encoding migration. Pass NextKey as the start key
+// of the next batch; it is empty once the range is done.
+type MigrationResult struct {
+	Scanned  int32  `json:"scanned"`
+	Migrated int32  `json:"migrated"`
+	NextKey  string `json:"nextKey"`
+}
+
+// migrateRange calls migrate for up to limit values of a range; migrate returns true when it
+// rewrote the value
+func migrateRange(resultsIterator shim.StateQueryIteratorInterface, limit int32, migrate func(key string, value []byte) (bool, error)) (*MigrationResult, error) {
+	result := &MigrationResult{}
+	for resultsIterator.HasNext() {
+		queryResult, err := resultsIterator.Next()
+		if err != nil {
+			return nil, errorf(CodeInternal, "could not fetch the details of the result iterator. %s", err)
+		}
+
+		if result.Scanned == limit {
+			result.NextKey = queryResult.Key
+			break
+		}
+		result.Scanned++
+
+		migrated, err := migrate(queryResult.Key, queryResult.Value)
+		if err != nil {
+			return nil, err
+		}
+		if migrated {
+			result.Migrated++
+		}
+	}
+	return result, nil
+}
+
+func checkMigrationLimit(limit int32) error {
+	if limit <= 0 || limit > maxCarPageSize {
+		return errorf(CodeInvalidArgument, "the migration limit must be between 1 and %d", maxCarPageSize)
+	}
+	return nil
+}
+
+// SetStateEncoding selects the encoding cars and orders are written in from now on. Values are
+// read in any encoding, so existing ones can be moved over with MigrateStateEncoding at any pace.
+// Protobuf values cannot be queried by CouchDB and are meant for LevelDB peers.
+func (c *CarContract) SetStateEncoding(ctx contractapi.TransactionContextInterface, encoding string) (string, error) {
+	err := checkAccess(ctx, "CarContract:SetStateEncoding")
+	if err != nil {
+		return "", err
+	}
+
+	_, err = SerializerFor(encoding)
+	if err != nil {
+		return "", err
+	}
+
+	config, err := readConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	config.StateEncoding = encoding
+	err = writeConfig(ctx, config)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("state encoding set to %v", encoding), nil
+}
+
+// MigrateStateEncoding rewrites up to limit cars from startKey on in the configured state encoding.
+// The car versions are left as they are and no events are emitted. Values that are not cars are skipped.
+func (c *CarContract) MigrateStateEncoding(ctx contractapi.TransactionContextInterface, startKey string, endKey string, limit int32) (*MigrationResult, error) {
+	err := checkAccess(ctx, "CarContract:MigrateStateEncoding")
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkMigrationLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	resultsIterator, err := ctx.GetStub().GetStateByRange(startKey, endKey)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not fetch the data by range. %s", err)
+	}
+	defer resultsIterator.Close()
+
+	return migrateRange(resultsIterator, limit, func(key string, value []byte) (bool, error) {
+		var car Car
+		err := unmarshalCar(value, &car)
+		if err != nil || car.AssetType != "car" {
+			return false, nil
+		}
+
+		encoded, err := marshalCar(ctx, &car)
+		if err != nil {
+			return false, err
+		}
+		if bytes.Equal(encoded, value) {
+			return false, nil
+		}
+
+		err = ctx.GetStub().PutState(key, encoded)
+		if err != nil {
+			return false, errorf(CodeInternal, "could not migrate the car %s. %s", key, err)
+		}
+		return true, nil
+	})
+}
+
+// MigrateOrderEncoding rewrites up to limit orders from startKey on in the configured state encoding
+func (o *OrderContract) MigrateOrderEncoding(ctx contractapi.TransactionContextInterface, startKey string, endKey string, limit int32) (*MigrationResult, error) {
+	err := checkAccess(ctx, "OrderContract:MigrateOrderEncoding")
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkMigrationLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	resultsIterator, err := ctx.GetStub().GetPrivateDataByRange(collectionName, startKey, endKey)
+	if err != nil {
+		return nil, errorf(CodeInternal, "could not fetch the private data by range. %s", err)
+	}
+	defer resultsIterator.Close()
+
+	return migrateRange(resultsIterator, limit, func(key string, value []byte) (bool, error) {
+		var order Order
+		err := unmarshalOrder(value, &order)
+		if err != nil || order.AssetType != "Order" {
+			return false, nil
+		}
+
+		encoded, err := marshalOrder(ctx, &order)
+		if err != nil {
+			return false, err
+		}
+		if bytes.Equal(encoded, value) {
+			return false, nil
+		}
+
+		err = ctx.GetStub().PutPrivateData(collectionName, key, encoded)
+		if err != nil {
+			return false, errorf(CodeInternal, "could not migrate the order %s. %s", key, err)
+		}
+		return true, nil
+	})
+}
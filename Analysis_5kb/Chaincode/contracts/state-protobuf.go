@@ -0,0 +1,303 @@
+package contracts
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// protobufSerializer stores cars and orders as the protobuf messages in state.proto. Zero values
+// are left out as in proto3 and unknown fields are skipped, so fields can be added later.
+type protobufSerializer struct{}
+
+// protoWriter appends the fields of one protobuf message
+type protoWriter []byte
+
+func (w *protoWriter) appendString(num protowire.Number, value string) {
+	if value == "" {
+		return
+	}
+	*w = protowire.AppendTag(*w, num, protowire.BytesType)
+	*w = protowire.AppendString(*w, value)
+}
+
+func (w *protoWriter) appendInt(num protowire.Number, value int64) {
+	if value == 0 {
+		return
+	}
+	*w = protowire.AppendTag(*w, num, protowire.VarintType)
+	*w = protowire.AppendVarint(*w, uint64(value))
+}
+
+func (w *protoWriter) appendMessage(num protowire.Number, message []byte) {
+	*w = protowire.AppendTag(*w, num, protowire.BytesType)
+	*w = protowire.AppendBytes(*w, message)
+}
+
+// readProto calls field for every varint and length-delimited field of a message, in order.
+// Varints are passed in value and length-delimited fields in data; other wire types are skipped.
+func readProto(message []byte, field func(num protowire.Number, value uint64, data []byte) error) error {
+	for len(message) > 0 {
+		num, typ, n := protowire.ConsumeTag(message)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		message = message[n:]
+
+		var value uint64
+		var data []byte
+		switch typ {
+		case protowire.VarintType:
+			value, n = protowire.ConsumeVarint(message)
+		case protowire.BytesType:
+			data, n = protowire.ConsumeBytes(message)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, message)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		message = message[n:]
+
+		if typ == protowire.VarintType || typ == protowire.BytesType {
+			err := field(num, value, data)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func encodeIdentity(identity *Identity) []byte {
+	var w protoWriter
+	w.appendString(1, identity.MSPID)
+	w.appendString(2, identity.EnrollmentID)
+	return w
+}
+
+func decodeIdentity(message []byte) (*Identity, error) {
+	identity := &Identity{}
+	err := readProto(message, func(num protowire.Number, value uint64, data []byte) error {
+		switch num {
+		case 1:
+			identity.MSPID = string(data)
+		case 2:
+			identity.EnrollmentID = string(data)
+		}
+		return nil
+	})
+	return identity, err
+}
+
+func encodeArchiveInfo(archive *ArchiveInfo) []byte {
+	var w protoWriter
+	w.appendString(1, archive.Reason)
+	if archive.ArchivedBy != nil {
+		w.appendMessage(2, encodeIdentity(archive.ArchivedBy))
+	}
+	w.appendString(3, archive.ArchivedAt)
+	return w
+}
+
+func decodeArchiveInfo(message []byte) (*ArchiveInfo, error) {
+	archive := &ArchiveInfo{}
+	err := readProto(message, func(num protowire.Number, value uint64, data []byte) error {
+		var err error
+		switch num {
+		case 1:
+			archive.Reason = string(data)
+		case 2:
+			archive.ArchivedBy, err = decodeIdentity(data)
+		case 3:
+			archive.ArchivedAt = string(data)
+		}
+		return err
+	})
+	return archive, err
+}
+
+func encodeDocument(document *Document) []byte {
+	var w protoWriter
+	w.appendString(1, document.Name)
+	w.appendString(2, document.SHA256)
+	w.appendString(3, document.MediaType)
+	w.appendInt(4, document.Size)
+	if document.UploadedBy != nil {
+		w.appendMessage(5, encodeIdentity(document.UploadedBy))
+	}
+	w.appendString(6, document.UploadedAt)
+	return w
+}
+
+func decodeDocument(message []byte) (*Document, error) {
+	document := &Document{}
+	err := readProto(message, func(num protowire.Number, value uint64, data []byte) error {
+		var err error
+		switch num {
+		case 1:
+			document.Name = string(data)
+		case 2:
+			document.SHA256 = string(data)
+		case 3:
+			document.MediaType = string(data)
+		case 4:
+			document.Size = int64(value)
+		case 5:
+			document.UploadedBy, err = decodeIdentity(data)
+		case 6:
+			document.UploadedAt = string(data)
+		}
+		return err
+	})
+	return document, err
+}
+
+func (protobufSerializer) MarshalCar(car *Car) ([]byte, error) {
+	var w protoWriter
+	w.appendString(1, car.AssetType)
+	w.appendString(2, car.CarId)
+	w.appendString(3, car.Color)
+	w.appendString(4, car.DateOfManufacture)
+	w.appendString(5, car.Make)
+	w.appendString(6, car.Model)
+	w.appendString(7, car.OwnedBy)
+	w.appendString(8, string(car.Status))
+	w.appendString(9, car.RegisteredOwner)
+	w.appendString(10, car.RegistrationNumber)
+	if car.Owner != nil {
+		w.appendMessage(11, encodeIdentity(car.Owner))
+	}
+	w.appendInt(12, car.Version)
+	if car.Archive != nil {
+		w.appendMessage(13, encodeArchiveInfo(car.Archive))
+	}
+	w.appendString(14, car.WMI)
+	w.appendInt(15, int64(car.ModelYear))
+	for _, document := range car.Documents {
+		w.appendMessage(16, encodeDocument(document))
+	}
+	w.appendString(17, car.OwnerCommitment)
+	return w, nil
+}
+
+func (protobufSerializer) UnmarshalCar(message []byte, car *Car) error {
+	*car = Car{}
+	return readProto(message, func(num protowire.Number, value uint64, data []byte) error {
+		var err error
+		switch num {
+		case 1:
+			car.AssetType = string(data)
+		case 2:
+			car.CarId = string(data)
+		case 3:
+			car.Color = string(data)
+		case 4:
+			car.DateOfManufacture = string(data)
+		case 5:
+			car.Make = string(data)
+		case 6:
+			car.Model = string(data)
+		case 7:
+			car.OwnedBy = string(data)
+		case 8:
+			car.Status = CarStatus(data)
+		case 9:
+			car.RegisteredOwner = string(data)
+		case 10:
+			car.RegistrationNumber = string(data)
+		case 11:
+			car.Owner, err = decodeIdentity(data)
+		case 12:
+			car.Version = int64(value)
+		case 13:
+			car.Archive, err = decodeArchiveInfo(data)
+		case 14:
+			car.WMI = string(data)
+		case 15:
+			car.ModelYear = int(int64(value))
+		case 16:
+			var document *Document
+			document, err = decodeDocument(data)
+			car.Documents = append(car.Documents, document)
+		case 17:
+			car.OwnerCommitment = string(data)
+		}
+		return err
+	})
+}
+
+func (protobufSerializer) MarshalOrder(order *Order) ([]byte, error) {
+	var w protoWriter
+	w.appendString(1, order.AssetType)
+	w.appendString(2, order.Color)
+	w.appendString(3, order.DealerName)
+	w.appendString(4, order.Make)
+	w.appendString(5, order.Model)
+	w.appendString(6, order.OrderID)
+	w.appendString(7, string(order.Status))
+	w.appendString(8, order.CarId)
+	if order.CreatedBy != nil {
+		w.appendMessage(9, encodeIdentity(order.CreatedBy))
+	}
+	w.appendString(10, order.CreatedAt)
+	if order.UpdatedBy != nil {
+		w.appendMessage(11, encodeIdentity(order.UpdatedBy))
+	}
+	w.appendString(12, order.UpdatedAt)
+	w.appendString(13, order.ExpiresAt)
+	w.appendInt(14, int64(order.Quantity))
+	for _, option := range order.Options {
+		w.appendString(15, option)
+	}
+	w.appendString(16, order.DeliveryDate)
+	w.appendString(17, order.Notes)
+	for _, carID := range order.CarIds {
+		w.appendString(18, carID)
+	}
+	return w, nil
+}
+
+func (protobufSerializer) UnmarshalOrder(message []byte, order *Order) error {
+	*order = Order{}
+	return readProto(message, func(num protowire.Number, value uint64, data []byte) error {
+		var err error
+		switch num {
+		case 1:
+			order.AssetType = string(data)
+		case 2:
+			order.Color = string(data)
+		case 3:
+			order.DealerName = string(data)
+		case 4:
+			order.Make = string(data)
+		case 5:
+			order.Model = string(data)
+		case 6:
+			order.OrderID = string(data)
+		case 7:
+			order.Status = OrderStatus(data)
+		case 8:
+			order.CarId = string(data)
+		case 9:
+			order.CreatedBy, err = decodeIdentity(data)
+		case 10:
+			order.CreatedAt = string(data)
+		case 11:
+			order.UpdatedBy, err = decodeIdentity(data)
+		case 12:
+			order.UpdatedAt = string(data)
+		case 13:
+			order.ExpiresAt = string(data)
+		case 14:
+			order.Quantity = int(value)
+		case 15:
+			order.Options = append(order.Options, string(data))
+		case 16:
+			order.DeliveryDate = string(data)
+		case 17:
+			order.Notes = string(data)
+		case 18:
+			order.CarIds = append(order.CarIds, string(data))
+		}
+		return err
+	})
+}
@@ -11,8 +11,9 @@ import (
 func main() {
 	carContract := new(contracts.CarContract)
 	orderContarct := new(contracts.OrderContract)
+	accessContract := new(contracts.AccessContract)
 
-	chaincode, err := contractapi.NewChaincode(carContract, orderContarct)
+	chaincode, err := contractapi.NewChaincode(carContract, orderContarct, accessContract)
 
 	if err != nil {
 		log.Panicf("Could not create chaincode : %v", err)
@@ -0,0 +1,150 @@
+package chaincodetest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"kbaauto/contracts"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestOwnershipTransfer(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("ManufacturerMSP")
+	worldState := prepWorldState(chaincodeStub)
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000}, nil)
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	owner := &contracts.Identity{MSPID: "ManufacturerMSP", EnrollmentID: "user1"}
+	bytes, err := json.Marshal(&contracts.Car{CarId: "car1", Status: contracts.StatusManufactured, Owner: owner})
+	require.NoError(t, err)
+	worldState["car1"] = bytes
+
+	// Assert only the owner can propose
+	setClient(transactionContext, "DealerMSP", "user1")
+	_, err = carAsset.ProposeTransfer(transactionContext, "car1", "DealerMSP", "user1", 3600)
+	requireError(t, err, contracts.CodeUnauthorized, "only the owner of car car1 can propose a transfer")
+
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+	_, err = carAsset.ProposeTransfer(transactionContext, "car1", "DealerMSP", "user1", 3600)
+	require.NoError(t, err)
+
+	transfer, err := carAsset.GetPendingTransfer(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Equal(t, "2023-11-14T22:13:20Z", transfer.ProposedAt)
+	require.Equal(t, "2023-11-14T23:13:20Z", transfer.ExpiresAt)
+
+	// Assert only the recipient can accept
+	_, err = carAsset.AcceptTransfer(transactionContext, "car1")
+	requireError(t, err, contracts.CodeUnauthorized, "only DealerMSP/user1 can accept the transfer of car car1")
+
+	setClient(transactionContext, "DealerMSP", "user1")
+	_, err = carAsset.AcceptTransfer(transactionContext, "car1")
+	require.NoError(t, err)
+
+	car, err := carAsset.ReadCar(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Equal(t, &contracts.Identity{MSPID: "DealerMSP", EnrollmentID: "user1"}, car.Owner)
+
+	_, err = carAsset.GetPendingTransfer(transactionContext, "car1")
+	requireError(t, err, contracts.CodeNotFound, "there is no pending transfer for car car1")
+}
+
+func TestOwnershipTransferExpiry(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("ManufacturerMSP")
+	worldState := prepWorldState(chaincodeStub)
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000}, nil)
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	owner := &contracts.Identity{MSPID: "ManufacturerMSP", EnrollmentID: "user1"}
+	bytes, err := json.Marshal(&contracts.Car{CarId: "car1", Status: contracts.StatusManufactured, Owner: owner})
+	require.NoError(t, err)
+	worldState["car1"] = bytes
+
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+	_, err = carAsset.ProposeTransfer(transactionContext, "car1", "DealerMSP", "user1", 60)
+	require.NoError(t, err)
+
+	// Assert the transfer cannot be accepted once it has expired
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000060}, nil)
+	setClient(transactionContext, "DealerMSP", "user1")
+	_, err = carAsset.AcceptTransfer(transactionContext, "car1")
+	requireError(t, err, contracts.CodeConflict, "the transfer of car car1 expired at 2023-11-14T22:14:20Z")
+}
+
+func TestOwnershipTransferRegisteredOwner(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("DealerMSP")
+	worldState := prepWorldState(chaincodeStub)
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000}, nil)
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	owner := &contracts.Identity{MSPID: "DealerMSP", EnrollmentID: "dealer1"}
+	bytes, err := json.Marshal(&contracts.Car{CarId: "car1", Status: contracts.StatusAtDealer, Owner: owner})
+	require.NoError(t, err)
+	worldState["car1"] = bytes
+
+	setClient(transactionContext, "DealerMSP", "dealer1")
+	_, err = carAsset.ProposeTransfer(transactionContext, "car1", "DealerMSP", "dealer2", 3600)
+	require.NoError(t, err)
+
+	// Assert a car sold after the proposal keeps its registered owner
+	setOwner(chaincodeStub, "Jane", "0123456789abcdef")
+	prepPrivateData(chaincodeStub)
+	_, err = carAsset.SellCar(transactionContext, "car1")
+	require.NoError(t, err)
+
+	setClient(transactionContext, "DealerMSP", "dealer2")
+	_, err = carAsset.AcceptTransfer(transactionContext, "car1")
+	requireError(t, err, contracts.CodeConflict, "the car car1 belongs to its registered owner and cannot be transferred")
+
+	car, err := carAsset.ReadCar(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Equal(t, car.OwnerCommitment, car.OwnedBy)
+
+	// Assert a car with a registered owner cannot be offered
+	setClient(transactionContext, "DealerMSP", "dealer1")
+	_, err = carAsset.CancelTransfer(transactionContext, "car1")
+	require.NoError(t, err)
+	_, err = carAsset.ProposeTransfer(transactionContext, "car1", "DealerMSP", "dealer2", 3600)
+	requireError(t, err, contracts.CodeConflict, "the car car1 belongs to its registered owner and cannot be transferred")
+}
+
+func TestOwnershipTransferScrappedCar(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("ManufacturerMSP")
+	worldState := prepWorldState(chaincodeStub)
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: 1700000000}, nil)
+
+	// Create CarContract instance
+	carAsset := contracts.CarContract{}
+
+	owner := &contracts.Identity{MSPID: "ManufacturerMSP", EnrollmentID: "user1"}
+	bytes, err := json.Marshal(&contracts.Car{CarId: "car1", Status: contracts.StatusManufactured, Owner: owner})
+	require.NoError(t, err)
+	worldState["car1"] = bytes
+
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+	_, err = carAsset.ProposeTransfer(transactionContext, "car1", "DealerMSP", "user1", 3600)
+	require.NoError(t, err)
+	_, err = carAsset.ScrapCar(transactionContext, "car1")
+	require.NoError(t, err)
+
+	// Assert a transfer proposed before the car was scrapped cannot be accepted
+	setClient(transactionContext, "DealerMSP", "user1")
+	_, err = carAsset.AcceptTransfer(transactionContext, "car1")
+	requireError(t, err, contracts.CodeConflict, "the car car1 cannot be transferred while Scrapped")
+
+	car, err := carAsset.ReadCar(transactionContext, "car1")
+	require.NoError(t, err)
+	require.Equal(t, owner, car.Owner)
+}
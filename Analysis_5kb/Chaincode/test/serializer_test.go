@@ -0,0 +1,241 @@
+package chaincodetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"kbaauto/contracts"
+
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/hyperledger/fabric-protos-go/ledger/queryresult"
+	"github.com/stretchr/testify/require"
+)
+
+// stateIterator iterates over the simple keys of an in-memory world state between startKey and endKey
+type stateIterator struct {
+	keys  []string
+	state map[string][]byte
+}
+
+func newStateIterator(state map[string][]byte, startKey string, endKey string) *stateIterator {
+	iterator := &stateIterator{state: state}
+	for key := range state {
+		if strings.HasPrefix(key, "\x00") || key < startKey || (endKey != "" && key >= endKey) {
+			continue
+		}
+		iterator.keys = append(iterator.keys, key)
+	}
+	sort.Strings(iterator.keys)
+	return iterator
+}
+
+func (i *stateIterator) HasNext() bool { return len(i.keys) > 0 }
+
+func (i *stateIterator) Close() error { return nil }
+
+func (i *stateIterator) Next() (*queryresult.KV, error) {
+	key := i.keys[0]
+	i.keys = i.keys[1:]
+	return &queryresult.KV{Key: key, Value: i.state[key]}, nil
+}
+
+// benchmarkCar returns a car carrying documents until its JSON has at least size bytes
+func benchmarkCar(size int) *contracts.Car {
+	owner := &contracts.Identity{MSPID: "ManufacturerMSP", EnrollmentID: "user1"}
+	car := &contracts.Car{
+		AssetType:         "car",
+		CarId:             "car1",
+		Make:              "Tata",
+		Model:             "Nexon",
+		Color:             "White",
+		DateOfManufacture: "2023-07-22",
+		OwnedBy:           "KBA3",
+		Status:            contracts.StatusRegistered,
+		Owner:             owner,
+		Version:           7,
+		Archive:           &contracts.ArchiveInfo{Reason: "sold abroad", ArchivedBy: owner, ArchivedAt: "2024-01-02T03:04:05Z"},
+		WMI:               "1HG",
+		ModelYear:         2023,
+	}
+	for i := 0; ; i++ {
+		bytes, _ := json.Marshal(car)
+		if len(bytes) >= size {
+			return car
+		}
+		car.Documents = append(car.Documents, &contracts.Document{
+			Name:       fmt.Sprintf("invoice-%d.pdf", i),
+			SHA256:     fmt.Sprintf("%064x", i),
+			MediaType:  "application/pdf",
+			Size:       int64(1000 + i),
+			UploadedBy: owner,
+			UploadedAt: "2024-01-02T03:04:05Z",
+		})
+	}
+}
+
+// payloadSizes are the payload sizes of the load test
+var payloadSizes = []int{5 << 10, 10 << 10, 20 << 10, 50 << 10}
+
+func TestStateSerializers(t *testing.T) {
+	car := benchmarkCar(1 << 10)
+	dealer := &contracts.Identity{MSPID: "DealerMSP", EnrollmentID: "dealer1"}
+	order := &contracts.Order{
+		AssetType:    "Order",
+		OrderID:      "order1",
+		Quantity:     2,
+		Options:      []string{"Sunroof", "Tow bar"},
+		DeliveryDate: "2024-03-01",
+		Notes:        "Deliver to the Kochi branch",
+		Make:         "Tata",
+		Model:        "Nexon",
+		Color:        "White",
+		DealerName:   "KBA3",
+		Status:       contracts.OrderMatched,
+		CarId:        "car1",
+		CarIds:       []string{"car2", "car1"},
+		CreatedBy:    dealer,
+		CreatedAt:    "2024-01-02T03:04:05Z",
+		UpdatedBy:    dealer,
+		UpdatedAt:    "2024-01-03T03:04:05Z",
+		ExpiresAt:    "2024-02-01T03:04:05Z",
+	}
+
+	for _, encoding := range []string{contracts.StateEncodingJSON, contracts.StateEncodingProtobuf} {
+		serializer, err := contracts.SerializerFor(encoding)
+		require.NoError(t, err)
+
+		data, err := serializer.MarshalCar(car)
+		require.NoError(t, err)
+		var decodedCar contracts.Car
+		require.NoError(t, serializer.UnmarshalCar(data, &decodedCar))
+		require.Equal(t, car, &decodedCar, encoding)
+
+		data, err = serializer.MarshalOrder(order)
+		require.NoError(t, err)
+		var decodedOrder contracts.Order
+		require.NoError(t, serializer.UnmarshalOrder(data, &decodedOrder))
+		require.Equal(t, order, &decodedOrder, encoding)
+	}
+
+	_, err := contracts.SerializerFor("xml")
+	requireError(t, err, contracts.CodeInvalidArgument, "unknown state encoding xml, expected json or protobuf")
+}
+
+func TestMigrateStateEncoding(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("ManufacturerMSP")
+	worldState := prepWorldState(chaincodeStub)
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+	setAdmin(transactionContext, "user1")
+	chaincodeStub.GetStateByRangeCalls(func(startKey string, endKey string) (shim.StateQueryIteratorInterface, error) {
+		return newStateIterator(worldState, startKey, endKey), nil
+	})
+	privateData := map[string][]byte{}
+	chaincodeStub.PutPrivateDataCalls(func(collection string, key string, value []byte) error {
+		privateData[key] = value
+		return nil
+	})
+	chaincodeStub.GetPrivateDataCalls(func(collection string, key string) ([]byte, error) {
+		return privateData[key], nil
+	})
+	chaincodeStub.GetPrivateDataHashCalls(func(collection string, key string) ([]byte, error) {
+		return privateData[key], nil
+	})
+	chaincodeStub.GetPrivateDataByRangeCalls(func(collection string, startKey string, endKey string) (shim.StateQueryIteratorInterface, error) {
+		return newStateIterator(privateData, startKey, endKey), nil
+	})
+
+	// Create contract instances
+	carAsset := contracts.CarContract{}
+	orderAsset := contracts.OrderContract{}
+
+	for _, carID := range []string{"car1", "car2"} {
+		bytes, err := json.Marshal(&contracts.Car{AssetType: "car", CarId: carID, Make: "Tata", Status: contracts.StatusManufactured, Version: 3})
+		require.NoError(t, err)
+		worldState[carID] = bytes
+	}
+	bytes, err := json.Marshal(&contracts.Order{AssetType: "Order", OrderID: "order1", Make: "Tata"})
+	require.NoError(t, err)
+	privateData["order1"] = bytes
+
+	_, err = carAsset.SetStateEncoding(transactionContext, "xml")
+	requireError(t, err, contracts.CodeInvalidArgument, "unknown state encoding xml, expected json or protobuf")
+
+	_, err = carAsset.SetStateEncoding(transactionContext, contracts.StateEncodingProtobuf)
+	require.NoError(t, err)
+
+	// Assert the cars are migrated in batches and read in either encoding meanwhile
+	result, err := carAsset.MigrateStateEncoding(transactionContext, "", "", 1)
+	require.NoError(t, err)
+	require.Equal(t, &contracts.MigrationResult{Scanned: 1, Migrated: 1, NextKey: "car2"}, result)
+	require.Equal(t, byte(0x02), worldState["car1"][0])
+	require.Equal(t, byte('{'), worldState["car2"][0])
+
+	cars, err := carAsset.GetCarsByRange(transactionContext, "", "")
+	require.NoError(t, err)
+	require.Len(t, cars, 2)
+	require.Equal(t, "Tata", cars[0].Make)
+	require.Equal(t, int64(3), cars[0].Version)
+
+	result, err = carAsset.MigrateStateEncoding(transactionContext, result.NextKey, "", 10)
+	require.NoError(t, err)
+	require.Equal(t, &contracts.MigrationResult{Scanned: 1, Migrated: 1}, result)
+
+	// Assert a migrated car is left alone by the next run
+	result, err = carAsset.MigrateStateEncoding(transactionContext, "", "", 10)
+	require.NoError(t, err)
+	require.Equal(t, &contracts.MigrationResult{Scanned: 2}, result)
+
+	car, err := carAsset.ReadCar(transactionContext, "car2")
+	require.NoError(t, err)
+	require.Equal(t, "Tata", car.Make)
+
+	// Assert orders are migrated in the private data collection
+	result, err = orderAsset.MigrateOrderEncoding(transactionContext, "", "", 10)
+	require.NoError(t, err)
+	require.Equal(t, &contracts.MigrationResult{Scanned: 1, Migrated: 1}, result)
+	require.Equal(t, byte(0x02), privateData["order1"][0])
+
+	order, err := orderAsset.ReadOrder(transactionContext, "order1")
+	require.NoError(t, err)
+	require.Equal(t, "Tata", order.Make)
+
+	_, err = carAsset.MigrateStateEncoding(transactionContext, "", "", 0)
+	requireError(t, err, contracts.CodeInvalidArgument, "the migration limit must be between 1 and 200")
+}
+
+func BenchmarkMarshalCar(b *testing.B) {
+	for _, size := range payloadSizes {
+		car := benchmarkCar(size)
+		for _, encoding := range []string{contracts.StateEncodingJSON, contracts.StateEncodingProtobuf} {
+			serializer, _ := contracts.SerializerFor(encoding)
+			b.Run(fmt.Sprintf("%dkb/%s", size>>10, encoding), func(b *testing.B) {
+				var data []byte
+				for i := 0; i < b.N; i++ {
+					data, _ = serializer.MarshalCar(car)
+				}
+				b.ReportMetric(float64(len(data)), "state-bytes")
+			})
+		}
+	}
+}
+
+func BenchmarkUnmarshalCar(b *testing.B) {
+	for _, size := range payloadSizes {
+		car := benchmarkCar(size)
+		for _, encoding := range []string{contracts.StateEncodingJSON, contracts.StateEncodingProtobuf} {
+			serializer, _ := contracts.SerializerFor(encoding)
+			data, _ := serializer.MarshalCar(car)
+			b.Run(fmt.Sprintf("%dkb/%s", size>>10, encoding), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					var decoded contracts.Car
+					_ = serializer.UnmarshalCar(data, &decoded)
+				}
+				b.ReportMetric(float64(len(data)), "state-bytes")
+			})
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package chaincodetest
+
+import (
+	"strings"
+	"testing"
+
+	"kbaauto/contracts"
+	"kbaauto/validate"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCar(t *testing.T) {
+	car := &contracts.Car{
+		CarId:             "car 1",
+		Color:             "Blue",
+		DateOfManufacture: "22/07/2023",
+		Model:             strings.Repeat("x", 65),
+		OwnedBy:           "Factory-01",
+		Status:            "Parked",
+	}
+
+	// Assert every broken rule is reported by its JSON field name
+	err := validate.Struct(car)
+	require.EqualError(t, err, `carId contains the invalid character ' '; dateOfManufacture must be a YYYY-MM-DD date; make is required; model must have at most 64 characters; status must be one of Manufactured, InTransit, AtDealer, Sold, Registered, Scrapped`)
+
+	var errs validate.Errors
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 5)
+
+	// Assert only the selected fields are validated
+	require.NoError(t, validate.Struct(car, "color", "ownedBy"))
+}
+
+func TestValidateArguments(t *testing.T) {
+	// Set up mocks
+	transactionContext, chaincodeStub := prepMocks("ManufacturerMSP")
+	prepWorldState(chaincodeStub)
+	setClient(transactionContext, "ManufacturerMSP", "user1")
+
+	// Create contract instances
+	carAsset := contracts.CarContract{}
+	orderAsset := contracts.OrderContract{}
+
+	_, err := carAsset.CreateCar(transactionContext, "car1", "", "Civic", "Blue", "Factory-01", "22/07/2023")
+	requireError(t, err, contracts.CodeInvalidArgument, "invalid car. dateOfManufacture must be a YYYY-MM-DD date; make is required")
+
+	_, err = carAsset.CreateCar(transactionContext, "car1", "Honda", "Civic", "Blue", "Factory-01", "2023-07-22")
+	require.NoError(t, err)
+
+	_, err = carAsset.PatchCar(transactionContext, "car1", `{"model":"`+strings.Repeat("x", 100)+`"}`, 0)
+	requireError(t, err, contracts.CodeInvalidArgument, "invalid car. model must have at most 64 characters")
+
+	setClient(transactionContext, "DealerMSP", "dealer1")
+	chaincodeStub.GetTransientReturns(map[string][]byte{
+		"make":       []byte("Honda"),
+		"model":      []byte("Civic"),
+		"color":      []byte("<script>"),
+		"dealerName": []byte("Dealer1"),
+	}, nil)
+	_, err = orderAsset.CreateOrder(transactionContext, "order1")
+	requireError(t, err, contracts.CodeInvalidArgument, "invalid order. color contains the invalid character '<'")
+}
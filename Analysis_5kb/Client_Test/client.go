@@ -62,6 +62,20 @@ func submitTxnFn(organization string, channelName string, chaincodeName string,
 		}
 		return true
 
+	case "private":
+		_, err := contract.Submit(
+			txnName,
+			client.WithArguments(args...),
+			client.WithTransient(privateData),
+		)
+
+		if err != nil {
+			txnFail += 1
+			return false
+		}
+		txnSuccess += 1
+		return true
+
 	// case "query":
 	// 	evaluateResult, err := contract.EvaluateTransaction(txnName, args...)
 	// 	if err != nil {
@@ -79,19 +93,6 @@ func submitTxnFn(organization string, channelName string, chaincodeName string,
 	// 	// return fmt.Sprintf("*** Result:%s\n", result)
 	// 	return result
 
-	// case "private":
-	// 	result, err := contract.Submit(
-	// 		txnName,
-	// 		client.WithArguments(args...),
-	// 		client.WithTransient(privateData),
-	// 	)
-
-	// 	if err != nil {
-	// 		panic(fmt.Errorf("failed to submit transaction: %w", err))
-	// 	}
-
-	// 	return fmt.Sprintf("*** Transaction committed successfully\n result: %s \n", result)
-
 	// case "test":
 	// 	startTime := time.Now()
 
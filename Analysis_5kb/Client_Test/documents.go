@@ -0,0 +1,181 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/hyperledger/fabric-gateway/pkg/client"
+)
+
+// DocumentStore keeps document content on local disk, addressed by its SHA-256 hash
+type DocumentStore struct {
+	dir string
+}
+
+// CarDocument is the on-chain record of a document attached to a car
+type CarDocument struct {
+	Name      string `json:"name"`
+	SHA256    string `json:"sha256"`
+	MediaType string `json:"mediaType"`
+	Size      int64  `json:"size"`
+}
+
+func newDocumentStore(dir string) (*DocumentStore, error) {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create document store: %w", err)
+	}
+	return &DocumentStore{dir: dir}, nil
+}
+
+func documentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *DocumentStore) path(hash string) string {
+	return filepath.Join(s.dir, hash[:2], hash)
+}
+
+// Put stores the content under its hash and returns the hash. Storing the same content twice is a no-op.
+func (s *DocumentStore) Put(content []byte) (string, error) {
+	hash := documentHash(content)
+	path := s.path(hash)
+
+	_, err := os.Stat(path)
+	if err == nil {
+		return hash, nil
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return "", fmt.Errorf("failed to create document directory: %w", err)
+	}
+
+	// Write to a temporary file first so a crash never leaves a partial document under its hash
+	tmp, err := os.CreateTemp(filepath.Dir(path), hash+".tmp*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create document file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(content)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to write document: %w", err)
+	}
+
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return "", fmt.Errorf("failed to store document: %w", err)
+	}
+	return hash, nil
+}
+
+// Get returns the content stored under hash, after checking it still matches the hash
+func (s *DocumentStore) Get(hash string) ([]byte, error) {
+	if len(hash) != sha256.Size*2 {
+		return nil, fmt.Errorf("invalid document hash %q", hash)
+	}
+
+	content, err := os.ReadFile(s.path(hash))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read document %s: %w", hash, err)
+	}
+	if documentHash(content) != hash {
+		return nil, fmt.Errorf("document %s is corrupted", hash)
+	}
+	return content, nil
+}
+
+// attachDocument stores a file in the document store and records its hash on the car
+func attachDocument(contract *client.Contract, store *DocumentStore, carID string, filePath string, mediaType string) (string, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", filePath, err)
+	}
+
+	hash, err := store.Put(content)
+	if err != nil {
+		return "", err
+	}
+
+	// Attaching the same document again is not an error, so an interrupted attach can be retried
+	_, err = contract.SubmitTransaction("AttachDocument", carID, filepath.Base(filePath), hash, mediaType, strconv.Itoa(len(content)))
+	if err != nil && errorCode(err) != CodeAlreadyExists {
+		return "", fmt.Errorf("failed to attach document: %w", err)
+	}
+	return hash, nil
+}
+
+// verifyDocument checks downloaded content against the hash and size recorded on the car
+func verifyDocument(contract *client.Contract, carID string, content []byte) (*CarDocument, error) {
+	result, err := contract.EvaluateTransaction("ReadCar", carID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read car %s: %w", carID, err)
+	}
+
+	var car struct {
+		Documents []*CarDocument `json:"documents"`
+	}
+	err = json.Unmarshal(result, &car)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse car %s: %w", carID, err)
+	}
+
+	hash := documentHash(content)
+	for _, document := range car.Documents {
+		if document.SHA256 != hash {
+			continue
+		}
+		if document.Size != int64(len(content)) {
+			return nil, fmt.Errorf("document %s has %d bytes, the car records %d", hash, len(content), document.Size)
+		}
+		return document, nil
+	}
+	return nil, fmt.Errorf("no document with hash %s is attached to car %s", hash, carID)
+}
+
+// runDocumentCommand handles "attach <carID> <file> <mediaType>" and "verify <carID> <hash>",
+// keeping document content in the documents directory
+func runDocumentCommand(args []string) error {
+	store, err := newDocumentStore("documents")
+	if err != nil {
+		return err
+	}
+
+	gwConfig, err := initializeGateway("manufacturer", "autochannel", "KBA-Automobile", "CarContract")
+	if err != nil {
+		return err
+	}
+	defer gwConfig.gateway.Close()
+
+	switch {
+	case args[0] == "attach" && len(args) == 4:
+		hash, err := attachDocument(gwConfig.contract, store, args[1], args[2], args[3])
+		if err != nil {
+			return err
+		}
+		fmt.Printf("*** Document %s attached to car %s\n", hash, args[1])
+	case args[0] == "verify" && len(args) == 3:
+		content, err := store.Get(args[2])
+		if err != nil {
+			return err
+		}
+		document, err := verifyDocument(gwConfig.contract, args[1], content)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("*** Document %s (%s, %d bytes) matches car %s\n", document.Name, document.MediaType, document.Size, args[1])
+	default:
+		return fmt.Errorf("usage: attach <carID> <file> <mediaType> | verify <carID> <hash>")
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// createMode creates one small car per transaction, leaving out the payload of this directory
+const createMode = "create"
+
+// loadTestCarID is the car the payload load tests store their payloads on
+const loadTestCarID = "LoadTestCar"
+
+// payloadModes maps the payload load test modes to the chunked argument of PutCarPayload
+var payloadModes = map[string]bool{
+	"single":  false,
+	"chunked": true,
+}
+
+// validLoadTestMode returns true for the create mode and the payload modes
+func validLoadTestMode(mode string) bool {
+	_, ok := payloadModes[mode]
+	return ok || mode == createMode
+}
+
+// prepareLoadTestCar creates the load test car unless it already exists
+func prepareLoadTestCar(gwConfig *GatewayConfig) error {
+	_, err := gwConfig.contract.SubmitTransaction("CreateCar", loadTestCarID, "Tata", "Nexon", "White", "KBA3", "2023-07-22")
+	if err != nil && errorCode(err) != CodeAlreadyExists {
+		return fmt.Errorf("failed to create the load test car: %w", err)
+	}
+	return nil
+}
+
+// runLoadTest submits totalTxns transactions and returns the counters and the duration in seconds.
+// The create mode creates the cars Car2-0, Car2-1 and so on; the payload modes store copies of the
+// payload with PutCarPayload, either in a single key or in chunks.
+func runLoadTest(gwConfig *GatewayConfig, mode string, payload string, totalTxns int, numWorkers int) (*TxnCounters, float64) {
+	var counters TxnCounters
+	startTime := time.Now()
+	jobs := make(chan string, totalTxns)
+
+	txnName := "PutCarPayload"
+	keyFormat := mode + "-%d"
+	args := func(key string) []string {
+		return []string{loadTestCarID, key, payload, strconv.FormatBool(payloadModes[mode])}
+	}
+	if mode == createMode {
+		txnName = "CreateCar"
+		keyFormat = "Car2-%d"
+		args = func(key string) []string {
+			return []string{key, "Tata", "Nexon", "White", "KBA3", "2023-07-22"}
+		}
+	}
+
+	var wg sync.WaitGroup
+	for w := 1; w <= numWorkers; w++ {
+		wg.Add(1)
+		go worker(jobs, &wg, &counters, gwConfig, txnName, args)
+	}
+
+	for i := 0; i < totalTxns; i++ {
+		jobs <- fmt.Sprintf(keyFormat, i)
+	}
+	close(jobs)
+
+	wg.Wait()
+
+	return &counters, time.Since(startTime).Seconds()
+}